@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"blog/internal/adapter/export"
+	"blog/internal/app"
+
+	"github.com/spf13/cobra"
+)
+
+var exportOpts export.Options
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export the public blog as a static site",
+	Long: `export renders every published post, category and tag page, the feeds
+and the sitemaps into a directory that can be served from plain object storage.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.RunExport(exportOpts)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVarP(&exportOpts.OutDir, "out", "o", "./public", "output directory")
+	exportCmd.Flags().BoolVarP(&exportOpts.Incremental, "incremental", "i", false, "only re-render pages changed since the last export")
+	exportCmd.Flags().BoolVar(&exportOpts.Push, "push", false, "upload changed files to the configured R2 bucket")
+	exportCmd.Flags().StringVar(&exportOpts.PushPrefix, "prefix", "", "key prefix for files pushed to R2")
+}
@@ -3,10 +3,13 @@ package config
 import "github.com/spf13/viper"
 
 type App struct {
-	AppPort      string `json:"app_port"`
-	AppEnv       string `json:"app_env"`
-	JwtSecretKey string `json:"jwt_secret_key"`
-	JwtIssuer    string `json:"jwt_issuer"`
+	AppPort         string `json:"app_port"`
+	AppEnv          string `json:"app_env"`
+	JwtSecretKey    string `json:"jwt_secret_key"`
+	JwtIssuer       string `json:"jwt_issuer"`
+	PublicUrl       string `json:"public_url"`
+	SiteTitle       string `json:"site_title"`
+	SiteDescription string `json:"site_description"`
 }
 
 type PgsqlDB struct {
@@ -28,19 +31,68 @@ type CloudflareR2 struct {
 	PublicUrl  string `json:"public_url"`
 }
 
+type Theme struct {
+	Enabled bool   `json:"enabled"`
+	Dir     string `json:"dir"`
+	PerPage int    `json:"per_page"`
+}
+
+type Comment struct {
+	RateLimitMax    int `json:"rate_limit_max"`
+	RateLimitWindow int `json:"rate_limit_window"`
+}
+
+type Spam struct {
+	Threshold          float64 `json:"threshold"`
+	Secret             string  `json:"secret"`
+	LinkEnabled        bool    `json:"link_enabled"`
+	MaxLinks           int     `json:"max_links"`
+	BlocklistEnabled   bool    `json:"blocklist_enabled"`
+	HoneypotEnabled    bool    `json:"honeypot_enabled"`
+	TimingEnabled      bool    `json:"timing_enabled"`
+	TimingMinSeconds   int     `json:"timing_min_seconds"`
+	TimingMaxAge       int     `json:"timing_max_age"`
+	RepeatedEnabled    bool    `json:"repeated_enabled"`
+	RepeatedWindow     int     `json:"repeated_window"`
+	RepeatedMaxRepeats int     `json:"repeated_max_repeats"`
+}
+
+type View struct {
+	FlushInterval int `json:"flush_interval"`
+}
+
+type Locale struct {
+	Default   string `json:"default"`
+	Supported string `json:"supported"`
+}
+
+type Preview struct {
+	Secret string `json:"secret"`
+	TTL    int    `json:"ttl"`
+}
+
 type Config struct {
 	App     App
 	PgsqlDB PgsqlDB
 	R2      CloudflareR2
+	Theme   Theme
+	Comment Comment
+	Spam    Spam
+	View    View
+	Locale  Locale
+	Preview Preview
 }
 
 func NewConfig() *Config {
 	return &Config{
 		App: App{
-			AppPort:      viper.GetString("APP_PORT"),
-			AppEnv:       viper.GetString("APP_ENV"),
-			JwtSecretKey: viper.GetString("JWT_SECRET_KEY"),
-			JwtIssuer:    viper.GetString("JWT_ISSUER"),
+			AppPort:         viper.GetString("APP_PORT"),
+			AppEnv:          viper.GetString("APP_ENV"),
+			JwtSecretKey:    viper.GetString("JWT_SECRET_KEY"),
+			JwtIssuer:       viper.GetString("JWT_ISSUER"),
+			PublicUrl:       viper.GetString("APP_PUBLIC_URL"),
+			SiteTitle:       viper.GetString("APP_SITE_TITLE"),
+			SiteDescription: viper.GetString("APP_SITE_DESCRIPTION"),
 		},
 		PgsqlDB: PgsqlDB{
 			DbHost:     viper.GetString("DATABASE_HOST"),
@@ -59,5 +111,39 @@ func NewConfig() *Config {
 			AccountID:  viper.GetString("CLOUDFLARE_R2_ACCOUNT_ID"),
 			PublicUrl:  viper.GetString("CLOUDFLARE_R2_PUBLIC_URL"),
 		},
+		Theme: Theme{
+			Enabled: viper.GetBool("THEME_ENABLED"),
+			Dir:     viper.GetString("THEME_DIR"),
+			PerPage: viper.GetInt("THEME_PER_PAGE"),
+		},
+		Comment: Comment{
+			RateLimitMax:    viper.GetInt("COMMENT_RATE_LIMIT_MAX"),
+			RateLimitWindow: viper.GetInt("COMMENT_RATE_LIMIT_WINDOW"),
+		},
+		Spam: Spam{
+			Threshold:          viper.GetFloat64("SPAM_THRESHOLD"),
+			Secret:             viper.GetString("SPAM_SECRET"),
+			LinkEnabled:        viper.GetBool("SPAM_LINK_ENABLED"),
+			MaxLinks:           viper.GetInt("SPAM_MAX_LINKS"),
+			BlocklistEnabled:   viper.GetBool("SPAM_BLOCKLIST_ENABLED"),
+			HoneypotEnabled:    viper.GetBool("SPAM_HONEYPOT_ENABLED"),
+			TimingEnabled:      viper.GetBool("SPAM_TIMING_ENABLED"),
+			TimingMinSeconds:   viper.GetInt("SPAM_TIMING_MIN_SECONDS"),
+			TimingMaxAge:       viper.GetInt("SPAM_TIMING_MAX_AGE"),
+			RepeatedEnabled:    viper.GetBool("SPAM_REPEATED_ENABLED"),
+			RepeatedWindow:     viper.GetInt("SPAM_REPEATED_WINDOW"),
+			RepeatedMaxRepeats: viper.GetInt("SPAM_REPEATED_MAX_REPEATS"),
+		},
+		View: View{
+			FlushInterval: viper.GetInt("VIEW_FLUSH_INTERVAL"),
+		},
+		Locale: Locale{
+			Default:   viper.GetString("LOCALE_DEFAULT"),
+			Supported: viper.GetString("LOCALE_SUPPORTED"),
+		},
+		Preview: Preview{
+			Secret: viper.GetString("PREVIEW_SECRET"),
+			TTL:    viper.GetInt("PREVIEW_TTL"),
+		},
 	}
 }
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_categories_display_order;
+
+ALTER TABLE "categories"
+  DROP COLUMN IF EXISTS description,
+  DROP COLUMN IF EXISTS image,
+  DROP COLUMN IF EXISTS meta_title,
+  DROP COLUMN IF EXISTS meta_description,
+  DROP COLUMN IF EXISTS display_order;
@@ -0,0 +1,8 @@
+ALTER TABLE "categories"
+  ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS image TEXT NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS meta_title VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS meta_description VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS display_order INT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_categories_display_order ON categories(display_order);
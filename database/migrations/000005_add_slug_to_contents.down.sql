@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_contents_slug;
+
+ALTER TABLE "contents" DROP COLUMN IF EXISTS slug;
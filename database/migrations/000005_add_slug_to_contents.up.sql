@@ -0,0 +1,9 @@
+ALTER TABLE "contents" ADD COLUMN IF NOT EXISTS slug VARCHAR(255) NULL;
+
+UPDATE "contents"
+SET slug = trim(both '-' from lower(regexp_replace(title, '[^a-zA-Z0-9]+', '-', 'g'))) || '-' || id
+WHERE slug IS NULL;
+
+ALTER TABLE "contents" ALTER COLUMN slug SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_contents_slug ON contents(slug);
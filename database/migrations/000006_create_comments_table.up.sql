@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "comments" (
+  id SERIAL PRIMARY KEY,
+  content_id INT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+  parent_id INT NULL REFERENCES comments(id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  body TEXT NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+  ip_address VARCHAR(45) NOT NULL DEFAULT '',
+  user_agent TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_comments_content_id_status ON comments(content_id, status);
+CREATE INDEX idx_comments_parent_id ON comments(parent_id);
+CREATE INDEX idx_comments_status ON comments(status);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS "spam_blocklist";
+
+DROP INDEX IF EXISTS idx_comments_fingerprint;
+
+ALTER TABLE comments
+  DROP COLUMN IF EXISTS spam_reasons,
+  DROP COLUMN IF EXISTS spam_score,
+  DROP COLUMN IF EXISTS fingerprint;
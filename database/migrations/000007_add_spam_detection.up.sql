@@ -0,0 +1,15 @@
+ALTER TABLE comments
+  ADD COLUMN fingerprint VARCHAR(64) NOT NULL DEFAULT '',
+  ADD COLUMN spam_score REAL NOT NULL DEFAULT 0,
+  ADD COLUMN spam_reasons TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX idx_comments_fingerprint ON comments(fingerprint);
+
+CREATE TABLE IF NOT EXISTS "spam_blocklist" (
+  id SERIAL PRIMARY KEY,
+  kind VARCHAR(10) NOT NULL,
+  value VARCHAR(255) NOT NULL,
+  source VARCHAR(10) NOT NULL DEFAULT 'MANUAL',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT uq_spam_blocklist_kind_value UNIQUE (kind, value)
+);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "content_reactions";
+
+DROP INDEX IF EXISTS idx_contents_reaction_count;
+
+ALTER TABLE contents DROP COLUMN IF EXISTS reaction_count;
@@ -0,0 +1,14 @@
+ALTER TABLE contents ADD COLUMN reaction_count INT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_contents_reaction_count ON contents(reaction_count);
+
+CREATE TABLE IF NOT EXISTS "content_reactions" (
+  id SERIAL PRIMARY KEY,
+  content_id INT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+  reaction VARCHAR(20) NOT NULL,
+  visitor_hash VARCHAR(64) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT uq_content_reactions_visitor UNIQUE (content_id, reaction, visitor_hash)
+);
+
+CREATE INDEX idx_content_reactions_content_id_reaction ON content_reactions(content_id, reaction);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "content_view_daily";
+
+ALTER TABLE contents DROP COLUMN IF EXISTS view_count;
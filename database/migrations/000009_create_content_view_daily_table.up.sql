@@ -0,0 +1,11 @@
+ALTER TABLE contents ADD COLUMN view_count BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "content_view_daily" (
+  content_id INT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+  day DATE NOT NULL,
+  views INT NOT NULL DEFAULT 0,
+  unique_views INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (content_id, day)
+);
+
+CREATE INDEX idx_content_view_daily_day ON content_view_daily(day);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "series_contents";
+DROP TABLE IF EXISTS "series";
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS "series" (
+  id SERIAL PRIMARY KEY,
+  user_id INT NOT NULL REFERENCES users(id),
+  title VARCHAR(255) NOT NULL,
+  slug VARCHAR(255) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_series_slug ON series(slug);
+
+CREATE TABLE IF NOT EXISTS "series_contents" (
+  series_id INT NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  content_id INT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  PRIMARY KEY (series_id, content_id),
+  CONSTRAINT uq_series_contents_content UNIQUE (content_id),
+  CONSTRAINT uq_series_contents_position UNIQUE (series_id, position)
+);
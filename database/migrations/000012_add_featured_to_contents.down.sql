@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_contents_category_id_pinned;
+DROP INDEX IF EXISTS idx_contents_featured_position;
+
+ALTER TABLE contents DROP COLUMN IF EXISTS pinned;
+ALTER TABLE contents DROP COLUMN IF EXISTS featured_until;
+ALTER TABLE contents DROP COLUMN IF EXISTS featured_position;
@@ -0,0 +1,6 @@
+ALTER TABLE contents ADD COLUMN featured_position INT NULL;
+ALTER TABLE contents ADD COLUMN featured_until TIMESTAMP NULL;
+ALTER TABLE contents ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_contents_featured_position ON contents(featured_position) WHERE featured_position IS NOT NULL;
+CREATE INDEX idx_contents_category_id_pinned ON contents(category_id, pinned);
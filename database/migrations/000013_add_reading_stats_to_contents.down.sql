@@ -0,0 +1,3 @@
+ALTER TABLE contents DROP COLUMN IF EXISTS toc;
+ALTER TABLE contents DROP COLUMN IF EXISTS reading_time;
+ALTER TABLE contents DROP COLUMN IF EXISTS word_count;
@@ -0,0 +1,10 @@
+ALTER TABLE contents ADD COLUMN word_count INT NOT NULL DEFAULT 0;
+ALTER TABLE contents ADD COLUMN reading_time INT NOT NULL DEFAULT 0;
+ALTER TABLE contents ADD COLUMN toc TEXT NOT NULL DEFAULT '';
+
+-- Approximate the statistics of existing contents; the table of contents is
+-- filled in the next time a content is saved.
+UPDATE contents SET word_count = COALESCE(array_length(regexp_split_to_array(btrim(regexp_replace(description, '<[^>]*>', ' ', 'g')), '\s+'), 1), 0)
+WHERE btrim(regexp_replace(description, '<[^>]*>', ' ', 'g')) <> '';
+
+UPDATE contents SET reading_time = CEIL(word_count / 200.0) WHERE word_count > 0;
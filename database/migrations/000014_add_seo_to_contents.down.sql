@@ -0,0 +1,6 @@
+ALTER TABLE contents DROP COLUMN IF EXISTS json_ld;
+ALTER TABLE contents DROP COLUMN IF EXISTS no_index;
+ALTER TABLE contents DROP COLUMN IF EXISTS og_image;
+ALTER TABLE contents DROP COLUMN IF EXISTS canonical_url;
+ALTER TABLE contents DROP COLUMN IF EXISTS meta_description;
+ALTER TABLE contents DROP COLUMN IF EXISTS meta_title;
@@ -0,0 +1,6 @@
+ALTER TABLE contents ADD COLUMN meta_title VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE contents ADD COLUMN meta_description VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE contents ADD COLUMN canonical_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE contents ADD COLUMN og_image TEXT NOT NULL DEFAULT '';
+ALTER TABLE contents ADD COLUMN no_index BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE contents ADD COLUMN json_ld TEXT NOT NULL DEFAULT '';
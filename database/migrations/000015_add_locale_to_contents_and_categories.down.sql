@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_categories_locale;
+DROP INDEX IF EXISTS uq_categories_translation_group_locale;
+ALTER TABLE categories DROP COLUMN IF EXISTS translation_group_id;
+ALTER TABLE categories DROP COLUMN IF EXISTS locale;
+
+DROP INDEX IF EXISTS idx_contents_locale;
+DROP INDEX IF EXISTS uq_contents_translation_group_locale;
+ALTER TABLE contents DROP COLUMN IF EXISTS translation_group_id;
+ALTER TABLE contents DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,14 @@
+ALTER TABLE contents ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT 'en';
+ALTER TABLE contents ADD COLUMN translation_group_id INT NOT NULL DEFAULT 0;
+UPDATE contents SET translation_group_id = id;
+
+-- a new row briefly has group 0 until it is set to its own id
+CREATE UNIQUE INDEX uq_contents_translation_group_locale ON contents(translation_group_id, locale) WHERE translation_group_id <> 0;
+CREATE INDEX idx_contents_locale ON contents(locale);
+
+ALTER TABLE categories ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT 'en';
+ALTER TABLE categories ADD COLUMN translation_group_id INT NOT NULL DEFAULT 0;
+UPDATE categories SET translation_group_id = id;
+
+CREATE UNIQUE INDEX uq_categories_translation_group_locale ON categories(translation_group_id, locale) WHERE translation_group_id <> 0;
+CREATE INDEX idx_categories_locale ON categories(locale);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS "preview_tokens" (
+  id SERIAL PRIMARY KEY,
+  content_id INT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+  user_id INT NOT NULL REFERENCES users(id),
+  expires_at TIMESTAMP NOT NULL,
+  revoked_at TIMESTAMP NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_preview_tokens_content_id ON preview_tokens(content_id);
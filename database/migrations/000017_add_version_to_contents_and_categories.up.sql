@@ -0,0 +1,2 @@
+ALTER TABLE contents ADD COLUMN version INT NOT NULL DEFAULT 1;
+ALTER TABLE categories ADD COLUMN version INT NOT NULL DEFAULT 1;
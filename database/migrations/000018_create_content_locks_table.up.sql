@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS "content_locks" (
+  content_id INT PRIMARY KEY REFERENCES contents(id) ON DELETE CASCADE,
+  user_id INT NOT NULL REFERENCES users(id),
+  acquired_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at TIMESTAMP NOT NULL
+);
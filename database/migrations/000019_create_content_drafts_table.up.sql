@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS "content_drafts" (
+  content_id INT PRIMARY KEY REFERENCES contents(id) ON DELETE CASCADE,
+  user_id INT NOT NULL REFERENCES users(id),
+  category_id INT REFERENCES categories(id) ON DELETE SET NULL,
+  base_version INT NOT NULL,
+  title VARCHAR(255) NOT NULL DEFAULT '',
+  excerpt VARCHAR(255) NOT NULL DEFAULT '',
+  description text NOT NULL DEFAULT '',
+  image text NOT NULL DEFAULT '',
+  tags text NOT NULL DEFAULT '',
+  meta_title VARCHAR(255) NOT NULL DEFAULT '',
+  meta_description VARCHAR(255) NOT NULL DEFAULT '',
+  canonical_url text NOT NULL DEFAULT '',
+  og_image text NOT NULL DEFAULT '',
+  no_index BOOLEAN NOT NULL DEFAULT FALSE,
+  json_ld text NOT NULL DEFAULT '',
+  saved_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,7 @@
+DELETE FROM contents WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_contents_translation_group_locale;
+CREATE UNIQUE INDEX uq_contents_translation_group_locale ON contents(translation_group_id, locale) WHERE translation_group_id <> 0;
+
+DROP INDEX IF EXISTS idx_contents_deleted_at;
+ALTER TABLE contents DROP COLUMN IF EXISTS deleted_at;
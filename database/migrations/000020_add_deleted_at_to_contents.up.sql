@@ -0,0 +1,6 @@
+ALTER TABLE contents ADD COLUMN deleted_at TIMESTAMP NULL;
+CREATE INDEX idx_contents_deleted_at ON contents(deleted_at);
+
+-- a deleted content no longer holds its locale in the translation group
+DROP INDEX IF EXISTS uq_contents_translation_group_locale;
+CREATE UNIQUE INDEX uq_contents_translation_group_locale ON contents(translation_group_id, locale) WHERE translation_group_id <> 0 AND deleted_at IS NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "content_templates" (
+  id SERIAL PRIMARY KEY,
+  user_id INT NOT NULL REFERENCES users(id),
+  category_id INT NULL REFERENCES categories(id) ON DELETE SET NULL,
+  name VARCHAR(255) NOT NULL,
+  title_pattern VARCHAR(255) NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  tags TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "content_authors" (
+  content_id INT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+  user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  role VARCHAR(20) NOT NULL DEFAULT 'author',
+  position INT NOT NULL,
+  PRIMARY KEY (content_id, user_id),
+  CONSTRAINT chk_content_authors_role CHECK (role IN ('author', 'editor', 'contributor'))
+);
+
+CREATE INDEX idx_content_authors_user_id ON content_authors(user_id);
+
+-- every existing content is credited to its single author
+INSERT INTO content_authors (content_id, user_id, role, position)
+SELECT id, user_id, 'author', 1 FROM contents WHERE user_id IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS uq_users_slug;
+ALTER TABLE users DROP COLUMN IF EXISTS slug;
+ALTER TABLE users DROP COLUMN IF EXISTS social_links;
+ALTER TABLE users DROP COLUMN IF EXISTS avatar;
+ALTER TABLE users DROP COLUMN IF EXISTS bio;
+ALTER TABLE users DROP COLUMN IF EXISTS display_name;
@@ -0,0 +1,14 @@
+ALTER TABLE users ADD COLUMN display_name VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN avatar VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN social_links TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN slug VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Existing users get a slug from their name; the id keeps clashing names and
+-- names without latin letters apart.
+UPDATE users SET slug = btrim(lower(regexp_replace(name, '[^a-zA-Z0-9]+', '-', 'g')), '-');
+UPDATE users SET slug = 'author-' || id WHERE slug = '';
+UPDATE users SET slug = slug || '-' || id
+WHERE id NOT IN (SELECT MIN(id) FROM users GROUP BY slug);
+
+CREATE UNIQUE INDEX uq_users_slug ON users(slug) WHERE slug <> '';
@@ -18,6 +18,7 @@ func SeedUser(db *gorm.DB) {
 		Name:     "Admin",
 		Email:    "admin@gmail.com",
 		Password: string(bytes),
+		Slug:     conv.UniqueSlug(conv.GenerateSlug("Admin"), nil),
 	}
 
 	if err := db.FirstOrCreate(&admin, model.User{Email: "admin@gmail.com"}).Error; err != nil {
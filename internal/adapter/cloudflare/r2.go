@@ -5,7 +5,9 @@ import (
 	"blog/internal/core/domain/entity"
 	"context"
 	"fmt"
+	"mime"
 	"os"
+	"path/filepath"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -17,6 +19,8 @@ var err error
 
 type CloudFlareR2Adapter interface {
 	UploadImage(req *entity.FileUploadEntity) (string, error)
+	UploadFile(req *entity.FileUploadEntity) (string, error)
+	DeleteFile(name string) error
 }
 
 type cloudflareR2Adapter struct {
@@ -52,6 +56,58 @@ func (c *cloudflareR2Adapter) UploadImage(req *entity.FileUploadEntity) (string,
 	return fmt.Sprintf("%s/%s", c.Baseurl, req.Name), nil
 }
 
+// UploadFile implements CloudFlareR2Adapter.
+func (c *cloudflareR2Adapter) UploadFile(req *entity.FileUploadEntity) (string, error) {
+	openedFile, err := os.Open(req.Path)
+	if err != nil {
+		code = "[CLOUDFLARE R2] UploadFile - 1"
+		log.Errorw(code, err)
+		return "", err
+	}
+
+	defer openedFile.Close()
+
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(req.Path))
+	}
+
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	_, err = c.Client.PutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket:      aws.String(c.Bucket),
+		Key:         aws.String(req.Name),
+		Body:        openedFile,
+		ContentType: aws.String(contentType),
+	})
+
+	if err != nil {
+		code = "[CLOUDFLARE R2] UploadFile - 2"
+		log.Errorw(code, err)
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s", c.Baseurl, req.Name), nil
+}
+
+// DeleteFile implements CloudFlareR2Adapter.
+func (c *cloudflareR2Adapter) DeleteFile(name string) error {
+	_, err := c.Client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
+		Bucket: aws.String(c.Bucket),
+		Key:    aws.String(name),
+	})
+
+	if err != nil {
+		code = "[CLOUDFLARE R2] DeleteFile - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
 func NewCloudflareR2Adapter(client *s3.Client, cfg *config.Config) CloudFlareR2Adapter {
 	clientBase := s3.NewFromConfig(cfg.LoadAwsConfig(), func(o *s3.Options) {
 		o.BaseEndpoint = aws.String(fmt.Sprintf("https://%s.r2.cloudflarestorage.com", cfg.R2.AccountID))
@@ -0,0 +1,437 @@
+package export
+
+import (
+	"blog/config"
+	"blog/internal/adapter/cloudflare"
+	"blog/internal/adapter/repository"
+	"blog/internal/adapter/theme"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/feed"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	manifestName   = ".export-manifest.json"
+	exportPageSize = 500
+)
+
+var code string
+
+type Options struct {
+	OutDir      string
+	Incremental bool
+	Push        bool
+	PushPrefix  string
+}
+
+type Result struct {
+	Rendered int
+	Written  int
+	Removed  int
+	Pushed   int
+}
+
+type Exporter interface {
+	Export(ctx context.Context, opts Options) (*Result, error)
+}
+
+// manifest remembers what the previous export produced so an incremental
+// run can skip unchanged posts and every run can prune pages that no longer
+// exist. Dependencies digests what post pages show besides the post itself.
+type manifest struct {
+	GeneratedAt  time.Time         `json:"generated_at"`
+	Dependencies string            `json:"dependencies"`
+	Posts        map[string]string `json:"posts"`
+	Files        map[string]string `json:"files"`
+}
+
+type exporter struct {
+	site              theme.Site
+	feedService       service.FeedService
+	sitemapService    service.SitemapService
+	categoryService   service.CategoryService
+	sitemapRepository repository.SitemapRepository
+	r2                cloudflare.CloudFlareR2Adapter
+	cfg               *config.Config
+}
+
+type run struct {
+	opts     Options
+	previous manifest
+	current  manifest
+	changed  []string
+	result   Result
+}
+
+// Export implements Exporter.
+func (e *exporter) Export(ctx context.Context, opts Options) (*Result, error) {
+	startedAt := time.Now()
+
+	r := &run{
+		opts: opts,
+		current: manifest{
+			Posts: make(map[string]string),
+			Files: make(map[string]string),
+		},
+	}
+
+	if err := os.MkdirAll(opts.OutDir, 0755); err != nil {
+		return nil, err
+	}
+
+	// a full export still reads the manifest to prune what it no longer produces
+	r.previous = readManifest(opts.OutDir)
+
+	dependencies, err := e.sitemapRepository.GetPageDependencies(ctx)
+	if err != nil {
+		code = "[EXPORT] Export - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+	r.current.Dependencies = dependencies
+
+	if err = e.exportPosts(ctx, r); err != nil {
+		code = "[EXPORT] Export - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	// listings are always rendered, as category and metadata edits show
+	// there without touching any post; unchanged pages are not written again
+	if err = e.exportListings(ctx, r); err != nil {
+		code = "[EXPORT] Export - 3"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	removed, err := r.prune()
+	if err != nil {
+		code = "[EXPORT] Export - 4"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	if opts.Push {
+		if err = e.push(r, removed); err != nil {
+			code = "[EXPORT] Export - 5"
+			log.Errorw(code, err)
+			return nil, err
+		}
+	}
+
+	r.current.GeneratedAt = startedAt
+	if err = writeManifest(opts.OutDir, r.current); err != nil {
+		code = "[EXPORT] Export - 6"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return &r.result, nil
+}
+
+// exportPosts renders every published post. An incremental export skips
+// the posts that were not updated since the previous export, as long as
+// nothing else their pages show changed either.
+func (e *exporter) exportPosts(ctx context.Context, r *run) error {
+	incremental := r.opts.Incremental && !r.previous.GeneratedAt.IsZero() &&
+		r.previous.Dependencies == r.current.Dependencies
+
+	for offset := 0; ; offset += exportPageSize {
+		items, err := e.sitemapRepository.GetContents(ctx, offset, exportPageSize, false)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			file := path.Join("posts", item.Slug, "index.html")
+			r.current.Posts[item.Slug] = file
+
+			_, existed := r.previous.Posts[item.Slug]
+			upToDate := incremental && existed && item.UpdatedAt.Before(r.previous.GeneratedAt)
+
+			if upToDate {
+				if sum, ok := r.previous.Files[file]; ok {
+					r.current.Files[file] = sum
+					continue
+				}
+			}
+
+			err = r.render(file, func(w io.Writer) error {
+				return e.site.Post(ctx, w, item.Slug)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(items) < exportPageSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+// exportListings renders home, category and tag pages with their pagination,
+// the feeds and the sitemaps.
+func (e *exporter) exportListings(ctx context.Context, r *run) error {
+	err := r.renderPages("", func(w io.Writer, page int) error {
+		return e.site.Home(ctx, w, page)
+	})
+	if err != nil {
+		return err
+	}
+
+	// a failed lookup must not pass for an empty site, or pruning would
+	// remove every category page
+	categories, err := e.categoryService.GetCategories(ctx)
+	if err != nil {
+		code = "[EXPORT] exportListings - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	for _, category := range categories {
+		slug := category.Slug
+		err = r.renderPages(path.Join("categories", slug), func(w io.Writer, page int) error {
+			return e.site.Category(ctx, w, slug, page)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	tags, err := e.sitemapRepository.GetTags(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		name := tag.Slug
+		err = r.renderPages(path.Join("tags", name), func(w io.Writer, page int) error {
+			return e.site.Tag(ctx, w, name, page)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = e.exportFeeds(ctx, r); err != nil {
+		return err
+	}
+
+	return e.exportSitemaps(ctx, r)
+}
+
+func (e *exporter) exportFeeds(ctx context.Context, r *run) error {
+	result, err := e.feedService.GetFeed(ctx, entity.FeedQuery{})
+	if err != nil {
+		return err
+	}
+
+	feeds := map[string]func(*entity.FeedEntity) ([]byte, error){
+		"feed.xml":  feed.RSS,
+		"atom.xml":  feed.Atom,
+		"feed.json": feed.JSON,
+	}
+
+	for file, renderer := range feeds {
+		result.FeedLink = strings.TrimRight(e.cfg.App.PublicUrl, "/") + "/" + file
+
+		body, err := renderer(result)
+		if err != nil {
+			return err
+		}
+
+		if err = r.write(file, body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) exportSitemaps(ctx context.Context, r *run) error {
+	body, err := e.sitemapService.GetSitemapIndex(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = r.write("sitemap.xml", body); err != nil {
+		return err
+	}
+
+	sitemaps, err := e.sitemapService.GetSitemapList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range sitemaps {
+		body, err = e.sitemapService.GetSitemap(ctx, s.Kind, s.Page)
+		if err != nil {
+			return err
+		}
+
+		if err = r.write(service.SitemapFileName(s.Kind, s.Page), body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) push(r *run, removed []string) error {
+	for _, file := range r.changed {
+		_, err := e.r2.UploadFile(&entity.FileUploadEntity{
+			Name: r.opts.PushPrefix + file,
+			Path: filepath.Join(r.opts.OutDir, filepath.FromSlash(file)),
+		})
+		if err != nil {
+			return err
+		}
+
+		r.result.Pushed++
+	}
+
+	for _, file := range removed {
+		if err := e.r2.DeleteFile(r.opts.PushPrefix + file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderPages renders page 1 at dir/index.html and every following page at
+// dir/page/N/index.html until the theme runs out of pages.
+func (r *run) renderPages(dir string, render func(w io.Writer, page int) error) error {
+	for page := 1; ; page++ {
+		file := path.Join(dir, "index.html")
+		if page > 1 {
+			file = path.Join(dir, "page", strconv.Itoa(page), "index.html")
+		}
+
+		err := r.render(file, func(w io.Writer) error {
+			return render(w, page)
+		})
+		if errors.Is(err, theme.ErrNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (r *run) render(file string, render func(w io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := render(&buf); err != nil {
+		return err
+	}
+
+	r.result.Rendered++
+
+	return r.write(file, buf.Bytes())
+}
+
+// write stores body under the output directory. An incremental export skips
+// files the previous export already produced exactly the same.
+func (r *run) write(file string, body []byte) error {
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+	r.current.Files[file] = hash
+
+	target := filepath.Join(r.opts.OutDir, filepath.FromSlash(file))
+	if r.opts.Incremental && r.previous.Files[file] == hash {
+		if _, err := os.Stat(target); err == nil {
+			return nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(target, body, 0644); err != nil {
+		return err
+	}
+
+	r.changed = append(r.changed, file)
+	r.result.Written++
+
+	return nil
+}
+
+// prune removes files of the previous export that were not produced again.
+func (r *run) prune() ([]string, error) {
+	var removed []string
+	for file := range r.previous.Files {
+		if _, ok := r.current.Files[file]; ok {
+			continue
+		}
+
+		err := os.Remove(filepath.Join(r.opts.OutDir, filepath.FromSlash(file)))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		removed = append(removed, file)
+		r.result.Removed++
+	}
+
+	return removed, nil
+}
+
+func readManifest(dir string) manifest {
+	m := manifest{}
+
+	body, err := os.ReadFile(filepath.Join(dir, manifestName))
+	if err != nil {
+		return m
+	}
+
+	if err = json.Unmarshal(body, &m); err != nil {
+		code = "[EXPORT] readManifest - 1"
+		log.Errorw(code, err)
+		return manifest{}
+	}
+
+	return m
+}
+
+func writeManifest(dir string, m manifest) error {
+	body, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, manifestName), body, 0644)
+}
+
+func NewExporter(site theme.Site, feedService service.FeedService, sitemapService service.SitemapService, categoryService service.CategoryService, sitemapRepo repository.SitemapRepository, r2 cloudflare.CloudFlareR2Adapter, cfg *config.Config) Exporter {
+	return &exporter{
+		site:              site,
+		feedService:       feedService,
+		sitemapService:    sitemapService,
+		categoryService:   categoryService,
+		sitemapRepository: sitemapRepo,
+		r2:                r2,
+		cfg:               cfg,
+	}
+}
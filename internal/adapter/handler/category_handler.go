@@ -7,6 +7,7 @@ import (
 	"blog/internal/core/service"
 	"blog/lib/conv"
 	validatorLib "blog/lib/validator"
+	"errors"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
@@ -22,15 +23,22 @@ type CategoryHandler interface {
 	DeleteCategory(c *fiber.Ctx) error
 
 	GetCategoryFE(c *fiber.Ctx) error
+	GetCategoryBySlugFE(c *fiber.Ctx) error
 }
 
 type categoryHandler struct {
 	categoryService service.CategoryService
 }
 
-// GetCategoryFE implements CategoryHandler.
+// GetCategoryFE implements CategoryHandler. With ?lang= each category is
+// listed once, in that language when translated.
 func (ch *categoryHandler) GetCategoryFE(c *fiber.Ctx) error {
-	results, err := ch.categoryService.GetCategories(c.Context())
+	var results []entity.CategoryEntity
+	if lang := c.Query("lang"); lang != "" {
+		results, err = ch.categoryService.GetCategoriesByLocale(c.Context(), lang)
+	} else {
+		results, err = ch.categoryService.GetCategories(c.Context())
+	}
 	if err != nil {
 		code = "[HANDLER] GetCategories - 1"
 		log.Errorw(code, err)
@@ -42,10 +50,17 @@ func (ch *categoryHandler) GetCategoryFE(c *fiber.Ctx) error {
 	categoryResponses := []response.SuccessCategoryResponse{}
 	for _, result := range results {
 		categoryResponse := response.SuccessCategoryResponse{
-			ID:            result.ID,
-			Title:         result.Title,
-			Slug:          result.Slug,
-			CreatedByName: result.User.Name,
+			ID:              result.ID,
+			Title:           result.Title,
+			Slug:            result.Slug,
+			Description:     result.Description,
+			Image:           result.Image,
+			MetaTitle:       result.MetaTitle,
+			MetaDescription: result.MetaDescription,
+			DisplayOrder:    result.DisplayOrder,
+			Locale:          result.Locale,
+			PostCount:       result.PostCount,
+			CreatedByName:   result.User.Name,
 		}
 
 		categoryResponses = append(categoryResponses, categoryResponse)
@@ -59,6 +74,49 @@ func (ch *categoryHandler) GetCategoryFE(c *fiber.Ctx) error {
 	return c.JSON(successResponseDefault)
 }
 
+// GetCategoryBySlugFE implements CategoryHandler.
+func (ch *categoryHandler) GetCategoryBySlugFE(c *fiber.Ctx) error {
+	slug := c.Params("slug")
+	if slug == "" {
+		code = "[HANDLER] GetCategoryBySlugFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid category slug"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := ch.categoryService.GetCategoryBySlug(c.Context(), slug)
+	if err != nil {
+		code = "[HANDLER] GetCategoryBySlugFE - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	categoryResponse := response.SuccessCategoryResponse{
+		ID:              result.ID,
+		Title:           result.Title,
+		Slug:            result.Slug,
+		Description:     result.Description,
+		Image:           result.Image,
+		MetaTitle:       result.MetaTitle,
+		MetaDescription: result.MetaDescription,
+		DisplayOrder:    result.DisplayOrder,
+		Locale:          result.Locale,
+		Translations:    translationResponses(result.Translations, true),
+		PostCount:       result.PostCount,
+		CreatedByName:   result.User.Name,
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Category fetched details successfully"
+	successResponseDefault.Data = categoryResponse
+
+	return c.JSON(successResponseDefault)
+}
+
 // CreateCategory implements CategoryHandler.
 func (ch *categoryHandler) CreateCategory(c *fiber.Ctx) error {
 	var req request.CategoryRequest
@@ -90,7 +148,14 @@ func (ch *categoryHandler) CreateCategory(c *fiber.Ctx) error {
 	}
 
 	reqEntity := entity.CategoryEntity{
-		Title: req.Title,
+		Title:           req.Title,
+		Description:     req.Description,
+		Image:           req.Image,
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		DisplayOrder:    req.DisplayOrder,
+		Locale:          req.Locale,
+		TranslationOf:   req.TranslationOf,
 		User: entity.UserEntity{
 			ID: int64(userID),
 		},
@@ -103,6 +168,14 @@ func (ch *categoryHandler) CreateCategory(c *fiber.Ctx) error {
 		errorResp.Meta.Status = false
 		errorResp.Meta.Message = err.Error()
 
+		switch {
+		case errors.Is(err, service.ErrLocaleNotSupported):
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		case errors.Is(err, service.ErrTranslationSourceNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrTranslationExists):
+			return c.Status(fiber.StatusConflict).JSON(errorResp)
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
 	}
 
@@ -169,6 +242,16 @@ func (ch *categoryHandler) EditCategoryByID(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
 	}
 
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		code = "[HANDLER] EditCategoryByID - 6"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "If-Match header with the category ETag is required"
+
+		return c.Status(fiber.StatusPreconditionRequired).JSON(errorResp)
+	}
+
 	if err := c.BodyParser(&req); err != nil {
 		code = "[HANDLER] EditCategoryByID - 2"
 		log.Errorw(code, err)
@@ -199,20 +282,46 @@ func (ch *categoryHandler) EditCategoryByID(c *fiber.Ctx) error {
 	}
 
 	reqEntity := entity.CategoryEntity{
-		ID:    id,
-		Title: req.Title,
+		ID:              id,
+		Title:           req.Title,
+		Description:     req.Description,
+		Image:           req.Image,
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		DisplayOrder:    req.DisplayOrder,
+		Locale:          req.Locale,
+		TranslationOf:   req.TranslationOf,
+		Version:         version,
 		User: entity.UserEntity{
 			ID: int64(userID),
 		},
 	}
 
 	err = ch.categoryService.EditCategoryByID(c.Context(), reqEntity)
+	if errors.Is(err, entity.ErrVersionConflict) {
+		current, err := ch.categoryService.GetCategoryByID(c.Context(), id)
+		if err == nil {
+			c.Set(fiber.HeaderETag, versionTag(current.Version))
+			return c.Status(fiber.StatusPreconditionFailed).JSON(response.ConflictResponse{
+				Meta:    response.Meta{Status: false, Message: entity.ErrVersionConflict.Error()},
+				Current: categoryAdminResponse(*current),
+			})
+		}
+	}
 	if err != nil {
 		code = "[HANDLER] EditCategoryByID - 5"
 		log.Errorw(code, err)
 		errorResp.Meta.Status = false
 		errorResp.Meta.Message = err.Error()
 
+		switch {
+		case errors.Is(err, service.ErrLocaleNotSupported):
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		case errors.Is(err, service.ErrTranslationSourceNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrTranslationExists):
+			return c.Status(fiber.StatusConflict).JSON(errorResp)
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
 	}
 
@@ -249,10 +358,17 @@ func (ch *categoryHandler) GetCategories(c *fiber.Ctx) error {
 	categoryResponses := []response.SuccessCategoryResponse{}
 	for _, result := range results {
 		categoryResponse := response.SuccessCategoryResponse{
-			ID:            result.ID,
-			Title:         result.Title,
-			Slug:          result.Slug,
-			CreatedByName: result.User.Name,
+			ID:              result.ID,
+			Title:           result.Title,
+			Slug:            result.Slug,
+			Description:     result.Description,
+			Image:           result.Image,
+			MetaTitle:       result.MetaTitle,
+			MetaDescription: result.MetaDescription,
+			DisplayOrder:    result.DisplayOrder,
+			Locale:          result.Locale,
+			PostCount:       result.PostCount,
+			CreatedByName:   result.User.Name,
 		}
 
 		categoryResponses = append(categoryResponses, categoryResponse)
@@ -298,21 +414,35 @@ func (ch *categoryHandler) GetCategoryByID(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
 	}
 
-	categoryResponse := response.SuccessCategoryResponse{
-		ID:            result.ID,
-		Title:         result.Title,
-		Slug:          result.Slug,
-		CreatedByName: result.User.Name,
-	}
+	c.Set(fiber.HeaderETag, versionTag(result.Version))
 
 	successResponseDefault.Pagination = nil
 	successResponseDefault.Meta.Status = true
 	successResponseDefault.Meta.Message = "Category fetched details successfully"
-	successResponseDefault.Data = categoryResponse
+	successResponseDefault.Data = categoryAdminResponse(*result)
 
 	return c.JSON(successResponseDefault)
 }
 
+// categoryAdminResponse maps a category to the admin detail response.
+func categoryAdminResponse(result entity.CategoryEntity) response.SuccessCategoryResponse {
+	return response.SuccessCategoryResponse{
+		ID:              result.ID,
+		Title:           result.Title,
+		Slug:            result.Slug,
+		Description:     result.Description,
+		Image:           result.Image,
+		MetaTitle:       result.MetaTitle,
+		MetaDescription: result.MetaDescription,
+		DisplayOrder:    result.DisplayOrder,
+		Locale:          result.Locale,
+		Translations:    translationResponses(result.Translations, false),
+		PostCount:       result.PostCount,
+		CreatedByName:   result.User.Name,
+		Version:         result.Version,
+	}
+}
+
 func NewCategoryHandler(categoryService service.CategoryService) CategoryHandler {
 	return &categoryHandler{
 		categoryService: categoryService,
@@ -0,0 +1,481 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/request"
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	validatorLib "blog/lib/validator"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type CommentHandler interface {
+	GetComments(c *fiber.Ctx) error
+	GetCommentByID(c *fiber.Ctx) error
+	EditCommentByID(c *fiber.Ctx) error
+	ApproveComment(c *fiber.Ctx) error
+	RejectComment(c *fiber.Ctx) error
+	SpamComment(c *fiber.Ctx) error
+	DeleteComment(c *fiber.Ctx) error
+	BulkComments(c *fiber.Ctx) error
+
+	// FE
+	GetCommentsFE(c *fiber.Ctx) error
+	CreateCommentFE(c *fiber.Ctx) error
+}
+
+type commentHandler struct {
+	commentService service.CommentService
+}
+
+// CreateCommentFE implements CommentHandler.
+func (ch *commentHandler) CreateCommentFE(c *fiber.Ctx) error {
+	var req request.CommentRequest
+
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] CreateCommentFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] CreateCommentFE - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] CreateCommentFE - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.CommentEntity{
+		ContentID: contentID,
+		ParentID:  req.ParentID,
+		Name:      req.Name,
+		Email:     req.Email,
+		Body:      req.Body,
+		IPAddress: c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		Honeypot:  req.Website,
+		Token:     req.Token,
+	}
+
+	err = ch.commentService.CreateComment(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] CreateCommentFE - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		switch {
+		case errors.Is(err, service.ErrCommentsClosed):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrCommentInvalidReply), errors.Is(err, service.ErrCommentEmptyBody):
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Comment submitted and awaiting moderation"
+
+	return c.Status(fiber.StatusCreated).JSON(successResponseDefault)
+}
+
+// GetCommentsFE implements CommentHandler.
+func (ch *commentHandler) GetCommentsFE(c *fiber.Ctx) error {
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] GetCommentsFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	results, err := ch.commentService.GetCommentsFE(c.Context(), contentID)
+	if err != nil {
+		code = "[HANDLER] GetCommentsFE - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrContentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Comments fetched successfully"
+	successResponseDefault.Data = publicCommentResponses(results)
+
+	return c.JSON(successResponseDefault)
+}
+
+// publicCommentResponses maps a comment thread to responses without any
+// moderation details such as email or IP address.
+func publicCommentResponses(results []entity.CommentEntity) []response.SuccessCommentResponse {
+	commentResponses := []response.SuccessCommentResponse{}
+	for _, result := range results {
+		commentResponse := response.SuccessCommentResponse{
+			ID:        result.ID,
+			ContentID: result.ContentID,
+			ParentID:  result.ParentID,
+			Name:      result.Name,
+			Body:      result.Body,
+			CreatedAt: result.CreatedAt.Format(time.RFC3339),
+		}
+
+		if len(result.Replies) > 0 {
+			commentResponse.Replies = publicCommentResponses(result.Replies)
+		}
+
+		commentResponses = append(commentResponses, commentResponse)
+	}
+
+	return commentResponses
+}
+
+// GetComments implements CommentHandler.
+func (ch *commentHandler) GetComments(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] GetComments - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	page := 1
+	if c.Query("page") != "" {
+		page, err = conv.StringToInt(c.Query("page"))
+		if err != nil {
+			code = "[HANDLER] GetComments - 2"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid page number"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	limit := 20
+	if c.Query("limit") != "" {
+		limit, err = conv.StringToInt(c.Query("limit"))
+		if err != nil {
+			code = "[HANDLER] GetComments - 3"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid limit number"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	var contentID int64
+	if c.Query("contentID") != "" {
+		contentID, err = conv.StringToInt64(c.Query("contentID"))
+		if err != nil {
+			code = "[HANDLER] GetComments - 4"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid content ID"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	status := entity.CommentStatusPending
+	if c.Query("status") != "" {
+		status = c.Query("status")
+	}
+
+	if status == "ALL" {
+		status = ""
+	}
+
+	reqEntity := entity.CommentQuery{
+		Limit:     limit,
+		Page:      page,
+		Status:    status,
+		ContentID: contentID,
+		Search:    c.Query("search"),
+	}
+
+	results, totalData, totalPages, err := ch.commentService.GetComments(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] GetComments - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	commentResponses := []response.SuccessCommentResponse{}
+	for _, result := range results {
+		commentResponses = append(commentResponses, adminCommentResponse(result))
+	}
+
+	successResponseDefault.Pagination = &response.PaginationResponse{
+		TotalRecords: int(totalData),
+		Page:         page,
+		PerPage:      limit,
+		TotalPages:   int(totalPages),
+	}
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Comments fetched successfully"
+	successResponseDefault.Data = commentResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+func adminCommentResponse(result entity.CommentEntity) response.SuccessCommentResponse {
+	return response.SuccessCommentResponse{
+		ID:           result.ID,
+		ContentID:    result.ContentID,
+		ParentID:     result.ParentID,
+		Name:         result.Name,
+		Email:        result.Email,
+		Body:         result.Body,
+		Status:       result.Status,
+		IPAddress:    result.IPAddress,
+		UserAgent:    result.UserAgent,
+		ContentTitle: result.Content.Title,
+		SpamScore:    result.SpamScore,
+		SpamReasons:  result.SpamReasons,
+		CreatedAt:    result.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// GetCommentByID implements CommentHandler.
+func (ch *commentHandler) GetCommentByID(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] GetCommentByID - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("commentId"))
+	if err != nil {
+		code = "[HANDLER] GetCommentByID - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid comment ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := ch.commentService.GetCommentByID(c.Context(), id)
+	if err != nil {
+		code = "[HANDLER] GetCommentByID - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Comment fetched successfully"
+	successResponseDefault.Data = adminCommentResponse(*result)
+
+	return c.JSON(successResponseDefault)
+}
+
+// EditCommentByID implements CommentHandler.
+func (ch *commentHandler) EditCommentByID(c *fiber.Ctx) error {
+	var req request.EditCommentRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] EditCommentByID - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] EditCommentByID - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] EditCommentByID - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("commentId"))
+	if err != nil {
+		code = "[HANDLER] EditCommentByID - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid comment ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.CommentEntity{
+		ID:   id,
+		Name: req.Name,
+		Body: req.Body,
+	}
+
+	err = ch.commentService.EditCommentByID(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] EditCommentByID - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Comment updated successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// ApproveComment implements CommentHandler.
+func (ch *commentHandler) ApproveComment(c *fiber.Ctx) error {
+	return ch.moderate(c, service.CommentActionApprove, "Comment approved successfully")
+}
+
+// RejectComment implements CommentHandler.
+func (ch *commentHandler) RejectComment(c *fiber.Ctx) error {
+	return ch.moderate(c, service.CommentActionReject, "Comment rejected successfully")
+}
+
+// SpamComment implements CommentHandler.
+func (ch *commentHandler) SpamComment(c *fiber.Ctx) error {
+	return ch.moderate(c, service.CommentActionSpam, "Comment marked as spam")
+}
+
+// DeleteComment implements CommentHandler.
+func (ch *commentHandler) DeleteComment(c *fiber.Ctx) error {
+	return ch.moderate(c, service.CommentActionDelete, "Comment deleted successfully")
+}
+
+// moderate applies a moderation action to the comment in the commentId parameter.
+func (ch *commentHandler) moderate(c *fiber.Ctx, action, message string) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] ModerateComment - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("commentId"))
+	if err != nil {
+		code = "[HANDLER] ModerateComment - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid comment ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	affected, err := ch.commentService.BulkComments(c.Context(), []int64{id}, action)
+	if err != nil {
+		code = "[HANDLER] ModerateComment - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	if affected == 0 {
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Comment not found"
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = message
+
+	return c.JSON(successResponseDefault)
+}
+
+// BulkComments implements CommentHandler.
+func (ch *commentHandler) BulkComments(c *fiber.Ctx) error {
+	var req request.BulkCommentRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] BulkComments - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] BulkComments - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] BulkComments - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	affected, err := ch.commentService.BulkComments(c.Context(), req.IDs, req.Action)
+	if err != nil {
+		code = "[HANDLER] BulkComments - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Bulk action applied successfully"
+	successResponseDefault.Data = response.BulkCommentResponse{
+		Action:   req.Action,
+		Affected: affected,
+	}
+
+	return c.JSON(successResponseDefault)
+}
+
+func NewCommentHandler(commentService service.CommentService) CommentHandler {
+	return &commentHandler{
+		commentService: commentService,
+	}
+}
@@ -6,9 +6,14 @@ import (
 	"blog/internal/core/domain/entity"
 	"blog/internal/core/service"
 	"blog/lib/conv"
+	"blog/lib/seo"
 	validatorLib "blog/lib/validator"
+	"blog/lib/visitor"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,14 +28,30 @@ type ContentHandler interface {
 	EditContentByID(c *fiber.Ctx) error
 	DeleteContent(c *fiber.Ctx) error
 	UploadImageR2(c *fiber.Ctx) error
+	GetContentViews(c *fiber.Ctx) error
+	AuditContent(c *fiber.Ctx) error
+	SetFeatured(c *fiber.Ctx) error
+	ReorderFeatured(c *fiber.Ctx) error
+	SetPinned(c *fiber.Ctx) error
+	SetContentAuthors(c *fiber.Ctx) error
+	SaveDraft(c *fiber.Ctx) error
+	DiscardDraft(c *fiber.Ctx) error
+	PublishDraft(c *fiber.Ctx) error
+	BulkContents(c *fiber.Ctx) error
+	CloneContent(c *fiber.Ctx) error
 
 	// FE
 	GetContentWithQuery(c *fiber.Ctx) error
 	GetContentDetail(c *fiber.Ctx) error
+	GetPopularContents(c *fiber.Ctx) error
+	GetRelatedContents(c *fiber.Ctx) error
+	GetFeaturedContents(c *fiber.Ctx) error
 }
 
 type contentHandler struct {
 	contentService service.ContentService
+	viewService    service.ViewService
+	visitor        visitor.Visitor
 }
 
 // GetContentDetail implements ContentHandler.
@@ -45,36 +66,59 @@ func (ch *contentHandler) GetContentDetail(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
 	}
 
+	// drafts are only readable through a preview link, and look the same as
+	// missing contents so their ids can't be probed
 	result, err := ch.contentService.GetContentByID(c.Context(), id)
-	if err != nil {
+	if err != nil || result.Status != "PUBLISH" {
 		code = "[HANDLER] GetContentDetail - 2"
 		log.Errorw(code, err)
 		errorResp.Meta.Status = false
-		errorResp.Meta.Message = err.Error()
-		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+		errorResp.Meta.Message = service.ErrContentNotFound.Error()
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
 	}
 
-	contentResponse := response.SuccessContentResponse{
-		ID:           result.ID,
-		Title:        result.Title,
-		Excerpt:      result.Excerpt,
-		Description:  result.Description,
-		Image:        result.Image,
-		Tags:         result.Tags,
-		Status:       result.Status,
-		CategoryID:   result.CategoryID,
-		UserID:       result.UserID,
-		CreatedAt:    result.CreatedAt.Format(time.RFC3339),
-		CategoryName: result.Category.Title,
-		Author:       result.User.Name,
-	}
+	ch.viewService.Track(result.ID, ch.visitor.Hash(c), c.Get(fiber.HeaderUserAgent))
 
 	successResponseDefault.Meta.Status = true
 	successResponseDefault.Meta.Message = "Success"
-	successResponseDefault.Data = contentResponse
+	successResponseDefault.Data = contentDetailResponse(*result)
 	return c.JSON(successResponseDefault)
 }
 
+// contentDetailResponse maps a content to the public detail response.
+func contentDetailResponse(result entity.ContentEntity) response.SuccessContentResponse {
+	return response.SuccessContentResponse{
+		ID:               result.ID,
+		Title:            result.Title,
+		Slug:             result.Slug,
+		Excerpt:          result.Excerpt,
+		Description:      result.Description,
+		Image:            result.Image,
+		Tags:             result.Tags,
+		Status:           result.Status,
+		Locale:           result.Locale,
+		Translations:     translationResponses(result.Translations, true),
+		WordCount:        result.WordCount,
+		ReadingTime:      result.ReadingTime,
+		Toc:              tocResponse(result.Toc),
+		Seo:              seoResponse(result, true),
+		CategoryID:       result.CategoryID,
+		UserID:           result.UserID,
+		CreatedAt:        result.CreatedAt.Format(time.RFC3339),
+		CategoryName:     result.Category.Title,
+		Authors:          authorResponses(result),
+		CommentCount:     result.CommentCount,
+		ReactionCount:    result.ReactionCount,
+		Reactions:        result.Reactions,
+		ViewCount:        result.ViewCount,
+		Featured:         result.FeaturedPosition > 0,
+		FeaturedPosition: result.FeaturedPosition,
+		FeaturedUntil:    optionalTime(result.FeaturedUntil),
+		Pinned:           result.Pinned,
+		Series:           seriesNavResponse(result.Series),
+	}
+}
+
 // GetContentWithQuery implements ContentHandler.
 func (ch *contentHandler) GetContentWithQuery(c *fiber.Ctx) error {
 	page := 1
@@ -128,6 +172,18 @@ func (ch *contentHandler) GetContentWithQuery(c *fiber.Ctx) error {
 		}
 	}
 
+	authorID := 0
+	if c.Query("authorID") != "" {
+		authorID, err = conv.StringToInt(c.Query("authorID"))
+		if err != nil {
+			code = "[HANDLER] GetContentWithQuery - 3"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = err.Error()
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
 	reqEntity := entity.QueryString{
 		Limit:      limit,
 		Page:       page,
@@ -136,6 +192,9 @@ func (ch *contentHandler) GetContentWithQuery(c *fiber.Ctx) error {
 		Search:     search,
 		Status:     "PUBLISH",
 		CategoryID: int64(categoryID),
+		AuthorID:   int64(authorID),
+		Featured:   c.QueryBool("featured"),
+		Locale:     c.Query("lang"),
 	}
 
 	results, totalData, totalPages, err := ch.contentService.GetContents(c.Context(), reqEntity)
@@ -150,18 +209,29 @@ func (ch *contentHandler) GetContentWithQuery(c *fiber.Ctx) error {
 	contentResponses := []response.SuccessContentResponse{}
 	for _, result := range results {
 		contentResponse := response.SuccessContentResponse{
-			ID:           result.ID,
-			Title:        result.Title,
-			Excerpt:      result.Excerpt,
-			Description:  result.Description,
-			Image:        result.Image,
-			Tags:         result.Tags,
-			Status:       result.Status,
-			CategoryID:   result.CategoryID,
-			UserID:       result.UserID,
-			CreatedAt:    result.CreatedAt.Format(time.RFC3339),
-			CategoryName: result.Category.Title,
-			Author:       result.User.Name,
+			ID:               result.ID,
+			Title:            result.Title,
+			Slug:             result.Slug,
+			Excerpt:          result.Excerpt,
+			Image:            result.Image,
+			Tags:             result.Tags,
+			Status:           result.Status,
+			Locale:           result.Locale,
+			WordCount:        result.WordCount,
+			ReadingTime:      result.ReadingTime,
+			CategoryID:       result.CategoryID,
+			UserID:           result.UserID,
+			CreatedAt:        result.CreatedAt.Format(time.RFC3339),
+			CategoryName:     result.Category.Title,
+			Authors:          authorResponses(result),
+			CommentCount:     result.CommentCount,
+			ReactionCount:    result.ReactionCount,
+			Reactions:        result.Reactions,
+			ViewCount:        result.ViewCount,
+			Featured:         result.FeaturedPosition > 0,
+			FeaturedPosition: result.FeaturedPosition,
+			FeaturedUntil:    optionalTime(result.FeaturedUntil),
+			Pinned:           result.Pinned,
 		}
 
 		contentResponses = append(contentResponses, contentResponse)
@@ -220,21 +290,82 @@ func (ch *contentHandler) CreateContent(c *fiber.Ctx) error {
 		Status:      req.Status,
 		CategoryID:  req.CategoryID,
 		UserID:      int64(UserID),
+
+		Locale:        req.Locale,
+		TranslationOf: req.TranslationOf,
+		TemplateID:    req.TemplateID,
+
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		CanonicalUrl:    req.CanonicalUrl,
+		OgImage:         req.OgImage,
+		NoIndex:         req.NoIndex,
+		JsonLD:          req.JsonLD,
 	}
 
-	err = ch.contentService.CreateContent(c.Context(), reqEntity)
+	id, err := ch.contentService.CreateContent(c.Context(), reqEntity)
 	if err != nil {
 		code = "[HANDLER] CreateContent - 4"
 		log.Errorw(code, err)
 		errorResp.Meta.Status = false
 		errorResp.Meta.Message = err.Error()
+		switch {
+		case errors.Is(err, seo.ErrInvalidJsonLD), errors.Is(err, service.ErrLocaleNotSupported), errors.Is(err, service.ErrContentIncomplete):
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		case errors.Is(err, service.ErrTranslationSourceNotFound), errors.Is(err, service.ErrTemplateNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrTranslationExists):
+			return c.Status(fiber.StatusConflict).JSON(errorResp)
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
 	}
 
-	successResponseDefault.Data = nil
 	successResponseDefault.Pagination = nil
 	successResponseDefault.Meta.Status = true
 	successResponseDefault.Meta.Message = "Content created successfully"
+	successResponseDefault.Data = map[string]int64{"id": id}
+
+	return c.Status(fiber.StatusCreated).JSON(successResponseDefault)
+}
+
+// CloneContent implements ContentHandler.
+func (ch *contentHandler) CloneContent(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	UserID := claims.UserID
+
+	if UserID == 0 {
+		code = "[HANDLER] CloneContent - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] CloneContent - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	newID, err := ch.contentService.CloneContent(c.Context(), id, int64(UserID))
+	if err != nil {
+		code = "[HANDLER] CloneContent - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrContentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Content cloned successfully"
+	successResponseDefault.Data = map[string]int64{"id": newID}
 
 	return c.Status(fiber.StatusCreated).JSON(successResponseDefault)
 }
@@ -293,6 +424,15 @@ func (ch *contentHandler) EditContentByID(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
 	}
 
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		code = "[HANDLER] EditContentByID - 6"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "If-Match header with the content ETag is required"
+		return c.Status(fiber.StatusPreconditionRequired).JSON(errorResp)
+	}
+
 	if err = c.BodyParser(&req); err != nil {
 		code = "[HANDLER] EditContentByID - 2"
 		log.Errorw(code, err)
@@ -330,14 +470,44 @@ func (ch *contentHandler) EditContentByID(c *fiber.Ctx) error {
 		Status:      req.Status,
 		CategoryID:  req.CategoryID,
 		UserID:      int64(UserID),
+
+		Locale:         req.Locale,
+		TranslationOf:  req.TranslationOf,
+		RegenerateSlug: req.RegenerateSlug,
+		Version:        version,
+
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		CanonicalUrl:    req.CanonicalUrl,
+		OgImage:         req.OgImage,
+		NoIndex:         req.NoIndex,
+		JsonLD:          req.JsonLD,
 	}
 
 	err = ch.contentService.EditContentByID(c.Context(), reqEntity)
+	if errors.Is(err, entity.ErrVersionConflict) {
+		current, err := ch.contentService.GetContentByID(c.Context(), id)
+		if err == nil {
+			c.Set(fiber.HeaderETag, versionTag(current.Version))
+			return c.Status(fiber.StatusPreconditionFailed).JSON(response.ConflictResponse{
+				Meta:    response.Meta{Status: false, Message: entity.ErrVersionConflict.Error()},
+				Current: contentAdminResponse(*current),
+			})
+		}
+	}
 	if err != nil {
 		code = "[HANDLER] EditContentByID - 5"
 		log.Errorw(code, err)
 		errorResp.Meta.Status = false
 		errorResp.Meta.Message = err.Error()
+		switch {
+		case errors.Is(err, seo.ErrInvalidJsonLD), errors.Is(err, service.ErrLocaleNotSupported):
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		case errors.Is(err, service.ErrTranslationSourceNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrTranslationExists), errors.Is(err, service.ErrContentPublished):
+			return c.Status(fiber.StatusConflict).JSON(errorResp)
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
 	}
 
@@ -349,6 +519,282 @@ func (ch *contentHandler) EditContentByID(c *fiber.Ctx) error {
 	return c.JSON(successResponseDefault)
 }
 
+// SaveDraft implements ContentHandler. Autosaves go to the working copy
+// and leave the content as readers see it.
+func (ch *contentHandler) SaveDraft(c *fiber.Ctx) error {
+	var req request.ContentDraftRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	UserID := claims.UserID
+
+	if UserID == 0 {
+		code = "[HANDLER] SaveDraft - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] SaveDraft - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] SaveDraft - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] SaveDraft - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	tags := []string{}
+	if req.Tags != "" {
+		tags = strings.Split(req.Tags, ",")
+	}
+
+	result, err := ch.contentService.SaveDraft(c.Context(), entity.ContentDraftEntity{
+		ContentID:       id,
+		Title:           req.Title,
+		Excerpt:         req.Excerpt,
+		Description:     req.Description,
+		Image:           req.Image,
+		Tags:            tags,
+		CategoryID:      req.CategoryID,
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		CanonicalUrl:    req.CanonicalUrl,
+		OgImage:         req.OgImage,
+		NoIndex:         req.NoIndex,
+		JsonLD:          req.JsonLD,
+		User: entity.UserEntity{
+			ID: int64(UserID),
+		},
+	})
+	if err != nil {
+		code = "[HANDLER] SaveDraft - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		switch {
+		case errors.Is(err, seo.ErrInvalidJsonLD):
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		case errors.Is(err, service.ErrContentNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Draft saved successfully"
+	successResponseDefault.Data = contentDraftResponse(result.Draft, result.Version)
+
+	return c.JSON(successResponseDefault)
+}
+
+// DiscardDraft implements ContentHandler.
+func (ch *contentHandler) DiscardDraft(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	UserID := claims.UserID
+
+	if UserID == 0 {
+		code = "[HANDLER] DiscardDraft - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] DiscardDraft - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = ch.contentService.DiscardDraft(c.Context(), id)
+	if err != nil {
+		code = "[HANDLER] DiscardDraft - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Draft discarded successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// PublishDraft implements ContentHandler. Like an edit it requires If-Match
+// with the content ETag.
+func (ch *contentHandler) PublishDraft(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	UserID := claims.UserID
+
+	if UserID == 0 {
+		code = "[HANDLER] PublishDraft - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		code = "[HANDLER] PublishDraft - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "If-Match header with the content ETag is required"
+		return c.Status(fiber.StatusPreconditionRequired).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] PublishDraft - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = ch.contentService.PublishDraft(c.Context(), entity.PublishDraftEntity{
+		ContentID:      id,
+		Version:        version,
+		RegenerateSlug: c.Query("regenerate_slug") == "true",
+		Rebase:         c.Query("rebase") == "true",
+	})
+	if errors.Is(err, entity.ErrVersionConflict) {
+		current, err := ch.contentService.GetContentByID(c.Context(), id)
+		if err == nil {
+			c.Set(fiber.HeaderETag, versionTag(current.Version))
+			return c.Status(fiber.StatusPreconditionFailed).JSON(response.ConflictResponse{
+				Meta:    response.Meta{Status: false, Message: entity.ErrVersionConflict.Error()},
+				Current: contentAdminResponse(*current),
+			})
+		}
+	}
+	if err != nil {
+		code = "[HANDLER] PublishDraft - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		switch {
+		case errors.Is(err, service.ErrContentNotFound), errors.Is(err, service.ErrDraftNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrDraftIncomplete):
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Draft published successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// BulkContents implements ContentHandler. The batch is all or nothing:
+// when any item fails nothing is applied and the results say why.
+func (ch *contentHandler) BulkContents(c *fiber.Ctx) error {
+	var req request.BulkContentRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	UserID := claims.UserID
+
+	if UserID == 0 {
+		code = "[HANDLER] BulkContents - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] BulkContents - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] BulkContents - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := ch.contentService.BulkContents(c.Context(), entity.BulkContentEntity{
+		IDs:        req.IDs,
+		Action:     req.Action,
+		Status:     req.Status,
+		CategoryID: req.CategoryID,
+		Tags:       req.Tags,
+		DryRun:     req.DryRun,
+	})
+	if err != nil {
+		code = "[HANDLER] BulkContents - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrBulkValueRequired) {
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	bulkResponse := response.BulkReportResponse{
+		Applied: result.Applied,
+		DryRun:  result.DryRun,
+		Results: []response.BulkResultResponse{},
+	}
+	for _, val := range result.Results {
+		bulkResponse.Results = append(bulkResponse.Results, response.BulkResultResponse{
+			ID:    val.ID,
+			Ok:    val.Error == "",
+			Error: val.Error,
+		})
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Bulk action applied successfully"
+	switch {
+	case result.DryRun:
+		successResponseDefault.Meta.Message = "Bulk action checked, nothing was changed"
+	case !result.Applied:
+		successResponseDefault.Meta.Status = false
+		successResponseDefault.Meta.Message = "Bulk action failed for some contents, nothing was changed"
+	}
+	successResponseDefault.Data = bulkResponse
+
+	if !result.DryRun && !result.Applied {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(successResponseDefault)
+	}
+
+	return c.JSON(successResponseDefault)
+}
+
 // GetContentByID implements ContentHandler.
 func (ch *contentHandler) GetContentByID(c *fiber.Ctx) error {
 	claims := c.Locals("user").(*entity.JwtData)
@@ -381,27 +827,117 @@ func (ch *contentHandler) GetContentByID(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
 	}
 
-	contentResponse := response.SuccessContentResponse{
-		ID:           result.ID,
-		Title:        result.Title,
-		Excerpt:      result.Excerpt,
-		Description:  result.Description,
-		Image:        result.Image,
-		Tags:         result.Tags,
-		Status:       result.Status,
-		CategoryID:   result.CategoryID,
-		UserID:       result.UserID,
-		CreatedAt:    result.CreatedAt.Format(time.RFC3339),
-		CategoryName: result.Category.Title,
-		Author:       result.User.Name,
-	}
+	c.Set(fiber.HeaderETag, versionTag(result.Version))
 
 	successResponseDefault.Meta.Status = true
 	successResponseDefault.Meta.Message = "Success"
-	successResponseDefault.Data = contentResponse
+	resp := contentAdminResponse(*result)
+	resp.Lock = lockResponse(result.Lock, int64(UserID))
+
+	successResponseDefault.Data = resp
 	return c.JSON(successResponseDefault)
 }
 
+// contentAdminResponse maps a content to the admin detail response, with
+// the raw SEO overrides and every translation.
+func contentAdminResponse(result entity.ContentEntity) response.SuccessContentResponse {
+	return response.SuccessContentResponse{
+		ID:               result.ID,
+		Title:            result.Title,
+		Slug:             result.Slug,
+		Excerpt:          result.Excerpt,
+		Description:      result.Description,
+		Image:            result.Image,
+		Tags:             result.Tags,
+		Status:           result.Status,
+		Locale:           result.Locale,
+		Translations:     translationResponses(result.Translations, false),
+		WordCount:        result.WordCount,
+		ReadingTime:      result.ReadingTime,
+		Toc:              tocResponse(result.Toc),
+		Seo:              seoResponse(result, false),
+		CategoryID:       result.CategoryID,
+		UserID:           result.UserID,
+		CreatedAt:        result.CreatedAt.Format(time.RFC3339),
+		CategoryName:     result.Category.Title,
+		Authors:          authorResponses(result),
+		CommentCount:     result.CommentCount,
+		ReactionCount:    result.ReactionCount,
+		Reactions:        result.Reactions,
+		ViewCount:        result.ViewCount,
+		Featured:         result.FeaturedPosition > 0,
+		FeaturedPosition: result.FeaturedPosition,
+		FeaturedUntil:    optionalTime(result.FeaturedUntil),
+		Pinned:           result.Pinned,
+		Series:           seriesNavResponse(result.Series),
+		Version:          result.Version,
+		Draft:            contentDraftResponse(result.Draft, result.Version),
+	}
+}
+
+// authorResponses maps the credits of a content, falling back to its primary
+// user for contents that were never credited.
+func authorResponses(result entity.ContentEntity) []response.ContentAuthorResponse {
+	if len(result.Authors) == 0 {
+		return []response.ContentAuthorResponse{{
+			UserID: result.User.ID,
+			Name:   result.User.Name,
+			Role:   entity.AuthorRoleAuthor,
+		}}
+	}
+
+	resps := []response.ContentAuthorResponse{}
+	for _, val := range result.Authors {
+		resps = append(resps, response.ContentAuthorResponse{
+			UserID: val.User.ID,
+			Name:   authorName(val.User),
+			Slug:   val.User.Slug,
+			Avatar: val.User.Avatar,
+			Role:   val.Role,
+		})
+	}
+
+	return resps
+}
+
+// authorName is the name a user is shown under publicly.
+func authorName(user entity.UserEntity) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+
+	return user.Name
+}
+
+// contentDraftResponse maps the working copy of a content, or nil when it
+// has none.
+func contentDraftResponse(draft *entity.ContentDraftEntity, version int) *response.ContentDraftResponse {
+	if draft == nil {
+		return nil
+	}
+
+	return &response.ContentDraftResponse{
+		BaseVersion: draft.BaseVersion,
+		Stale:       draft.BaseVersion != version,
+		Title:       draft.Title,
+		Excerpt:     draft.Excerpt,
+		Description: draft.Description,
+		Image:       draft.Image,
+		Tags:        draft.Tags,
+		CategoryID:  draft.CategoryID,
+		Seo: seoResponse(entity.ContentEntity{
+			MetaTitle:       draft.MetaTitle,
+			MetaDescription: draft.MetaDescription,
+			CanonicalUrl:    draft.CanonicalUrl,
+			OgImage:         draft.OgImage,
+			NoIndex:         draft.NoIndex,
+			JsonLD:          draft.JsonLD,
+		}, false),
+		SavedAt:     draft.SavedAt.Format(time.RFC3339),
+		SavedByName: draft.User.Name,
+	}
+}
+
 // GetContents implements ContentHandler.
 func (ch *contentHandler) GetContents(c *fiber.Ctx) error {
 	claims := c.Locals("user").(*entity.JwtData)
@@ -469,6 +1005,19 @@ func (ch *contentHandler) GetContents(c *fiber.Ctx) error {
 		}
 	}
 
+	authorID := 0
+	if c.Query("authorID") != "" {
+		authorID, err = conv.StringToInt(c.Query("authorID"))
+		if err != nil {
+			code := "[HANDLER] GetContents - 4"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid author ID"
+
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
 	reqEntity := entity.QueryString{
 		Limit:      limit,
 		Page:       page,
@@ -476,6 +1025,12 @@ func (ch *contentHandler) GetContents(c *fiber.Ctx) error {
 		OrderType:  orderType,
 		Search:     search,
 		CategoryID: int64(categoryID),
+		AuthorID:   int64(authorID),
+		Featured:   c.QueryBool("featured"),
+		Deleted:    c.QueryBool("deleted"),
+
+		Locale:         c.Query("lang"),
+		FallbackLocale: c.Query("lang"),
 	}
 
 	results, totalData, totalPages, err := ch.contentService.GetContents(c.Context(), reqEntity)
@@ -490,18 +1045,31 @@ func (ch *contentHandler) GetContents(c *fiber.Ctx) error {
 	contentResponses := []response.SuccessContentResponse{}
 	for _, result := range results {
 		contentResponse := response.SuccessContentResponse{
-			ID:           result.ID,
-			Title:        result.Title,
-			Excerpt:      result.Excerpt,
-			Description:  result.Description,
-			Image:        result.Image,
-			Tags:         result.Tags,
-			Status:       result.Status,
-			CategoryID:   result.CategoryID,
-			UserID:       result.UserID,
-			CreatedAt:    result.CreatedAt.Format(time.RFC3339),
-			CategoryName: result.Category.Title,
-			Author:       result.User.Name,
+			ID:               result.ID,
+			Title:            result.Title,
+			Slug:             result.Slug,
+			Excerpt:          result.Excerpt,
+			Description:      result.Description,
+			Image:            result.Image,
+			Tags:             result.Tags,
+			Status:           result.Status,
+			Locale:           result.Locale,
+			WordCount:        result.WordCount,
+			ReadingTime:      result.ReadingTime,
+			CategoryID:       result.CategoryID,
+			UserID:           result.UserID,
+			CreatedAt:        result.CreatedAt.Format(time.RFC3339),
+			CategoryName:     result.Category.Title,
+			Authors:          authorResponses(result),
+			CommentCount:     result.CommentCount,
+			ReactionCount:    result.ReactionCount,
+			Reactions:        result.Reactions,
+			ViewCount:        result.ViewCount,
+			Featured:         result.FeaturedPosition > 0,
+			FeaturedPosition: result.FeaturedPosition,
+			FeaturedUntil:    optionalTime(result.FeaturedUntil),
+			Pinned:           result.Pinned,
+			DeletedAt:        optionalTime(result.DeletedAt),
 		}
 
 		contentResponses = append(contentResponses, contentResponse)
@@ -588,8 +1156,719 @@ func (ch *contentHandler) UploadImageR2(c *fiber.Ctx) error {
 	return c.JSON(successResponseDefault)
 }
 
-func NewContentHandler(contentService service.ContentService) ContentHandler {
+// parseWindow parses a statistics window such as "7d" or "24h" into a
+// number of whole days.
+func parseWindow(window string) (int, error) {
+	if len(window) < 2 {
+		return 0, errors.New("invalid window")
+	}
+
+	value, err := strconv.Atoi(window[:len(window)-1])
+	if err != nil || value <= 0 {
+		return 0, errors.New("invalid window")
+	}
+
+	days := 0
+	switch window[len(window)-1] {
+	case 'd':
+		days = value
+	case 'h':
+		days = (value + 23) / 24
+	default:
+		return 0, errors.New("invalid window")
+	}
+
+	if days > 365 {
+		return 0, errors.New("window must be at most 365 days")
+	}
+
+	return days, nil
+}
+
+// GetPopularContents implements ContentHandler.
+func (ch *contentHandler) GetPopularContents(c *fiber.Ctx) error {
+	days, err := parseWindow(c.Query("window", "7d"))
+	if err != nil {
+		code = "[HANDLER] GetPopularContents - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	limit := 5
+	if c.Query("limit") != "" {
+		limit, err = conv.StringToInt(c.Query("limit"))
+		if err != nil || limit <= 0 || limit > 50 {
+			code = "[HANDLER] GetPopularContents - 2"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid limit number"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	results, err := ch.viewService.GetPopularContents(c.Context(), days, limit)
+	if err != nil {
+		code = "[HANDLER] GetPopularContents - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	contentResponses := []response.PopularContentResponse{}
+	for _, result := range results {
+		contentResponse := response.PopularContentResponse{
+			SuccessContentResponse: response.SuccessContentResponse{
+				ID:            result.Content.ID,
+				Title:         result.Content.Title,
+				Slug:          result.Content.Slug,
+				Excerpt:       result.Content.Excerpt,
+				Image:         result.Content.Image,
+				Tags:          result.Content.Tags,
+				Status:        result.Content.Status,
+				WordCount:     result.Content.WordCount,
+				ReadingTime:   result.Content.ReadingTime,
+				CategoryID:    result.Content.CategoryID,
+				UserID:        result.Content.UserID,
+				CreatedAt:     result.Content.CreatedAt.Format(time.RFC3339),
+				CategoryName:  result.Content.Category.Title,
+				Authors:       authorResponses(result.Content),
+				ReactionCount: result.Content.ReactionCount,
+				ViewCount:     result.Content.ViewCount,
+			},
+			WindowViews: result.Views,
+		}
+
+		contentResponses = append(contentResponses, contentResponse)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Popular contents fetched successfully"
+	successResponseDefault.Data = contentResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetRelatedContents implements ContentHandler.
+func (ch *contentHandler) GetRelatedContents(c *fiber.Ctx) error {
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] GetRelatedContents - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	limit := 4
+	if c.Query("limit") != "" {
+		limit, err = conv.StringToInt(c.Query("limit"))
+		if err != nil || limit <= 0 || limit > 20 {
+			code = "[HANDLER] GetRelatedContents - 2"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid limit number"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	results, err := ch.contentService.GetRelatedContents(c.Context(), id, limit)
+	if err != nil {
+		code = "[HANDLER] GetRelatedContents - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrContentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	contentResponses := []response.RelatedContentResponse{}
+	for _, result := range results {
+		contentResponse := response.RelatedContentResponse{
+			SuccessContentResponse: response.SuccessContentResponse{
+				ID:           result.Content.ID,
+				Title:        result.Content.Title,
+				Slug:         result.Content.Slug,
+				Excerpt:      result.Content.Excerpt,
+				Image:        result.Content.Image,
+				Tags:         result.Content.Tags,
+				Status:       result.Content.Status,
+				WordCount:    result.Content.WordCount,
+				ReadingTime:  result.Content.ReadingTime,
+				CategoryID:   result.Content.CategoryID,
+				UserID:       result.Content.UserID,
+				CreatedAt:    result.Content.CreatedAt.Format(time.RFC3339),
+				CategoryName: result.Content.Category.Title,
+				Authors:      authorResponses(result.Content),
+			},
+			Score: result.Score,
+		}
+
+		contentResponses = append(contentResponses, contentResponse)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Related contents fetched successfully"
+	successResponseDefault.Data = contentResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetContentViews implements ContentHandler.
+func (ch *contentHandler) GetContentViews(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] GetContentViews - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] GetContentViews - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	days, err := parseWindow(c.Query("window", "30d"))
+	if err != nil {
+		code = "[HANDLER] GetContentViews - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := ch.viewService.GetContentViews(c.Context(), id, days)
+	if err != nil {
+		code = "[HANDLER] GetContentViews - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	statsResponse := response.ViewStatsResponse{
+		ContentID:   result.ContentID,
+		TotalViews:  result.TotalViews,
+		Views:       result.Views,
+		UniqueViews: result.UniqueViews,
+		Daily:       []response.ViewDailyResponse{},
+	}
+
+	for _, daily := range result.Daily {
+		statsResponse.Daily = append(statsResponse.Daily, response.ViewDailyResponse{
+			Day:         daily.Day.Format(time.DateOnly),
+			Views:       daily.Views,
+			UniqueViews: daily.UniqueViews,
+		})
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Content views fetched successfully"
+	successResponseDefault.Data = statsResponse
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetFeaturedContents implements ContentHandler.
+func (ch *contentHandler) GetFeaturedContents(c *fiber.Ctx) error {
+	limit := 5
+	if c.Query("limit") != "" {
+		limit, err = conv.StringToInt(c.Query("limit"))
+		if err != nil || limit <= 0 || limit > 20 {
+			code = "[HANDLER] GetFeaturedContents - 1"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid limit number"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	reqEntity := entity.QueryString{
+		Limit:    limit,
+		Page:     1,
+		Status:   "PUBLISH",
+		Featured: true,
+		Locale:   c.Query("lang"),
+	}
+
+	results, _, _, err := ch.contentService.GetContents(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] GetFeaturedContents - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrLocaleNotSupported) {
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	contentResponses := []response.SuccessContentResponse{}
+	for _, result := range results {
+		contentResponse := response.SuccessContentResponse{
+			ID:               result.ID,
+			Title:            result.Title,
+			Slug:             result.Slug,
+			Excerpt:          result.Excerpt,
+			Image:            result.Image,
+			Tags:             result.Tags,
+			Status:           result.Status,
+			Locale:           result.Locale,
+			WordCount:        result.WordCount,
+			ReadingTime:      result.ReadingTime,
+			CategoryID:       result.CategoryID,
+			UserID:           result.UserID,
+			CreatedAt:        result.CreatedAt.Format(time.RFC3339),
+			CategoryName:     result.Category.Title,
+			Authors:          authorResponses(result),
+			CommentCount:     result.CommentCount,
+			ReactionCount:    result.ReactionCount,
+			Reactions:        result.Reactions,
+			ViewCount:        result.ViewCount,
+			Featured:         true,
+			FeaturedPosition: result.FeaturedPosition,
+			FeaturedUntil:    optionalTime(result.FeaturedUntil),
+			Pinned:           result.Pinned,
+		}
+
+		contentResponses = append(contentResponses, contentResponse)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Featured contents fetched successfully"
+	successResponseDefault.Data = contentResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+// SetFeatured implements ContentHandler.
+func (ch *contentHandler) SetFeatured(c *fiber.Ctx) error {
+	var req request.FeatureRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] SetFeatured - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] SetFeatured - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] SetFeatured - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] SetFeatured - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.FeatureEntity{
+		ContentID: id,
+		Featured:  req.Featured,
+		Position:  req.Position,
+	}
+
+	if req.FeaturedUntil != "" {
+		until, err := time.Parse(time.RFC3339, req.FeaturedUntil)
+		if err != nil {
+			code = "[HANDLER] SetFeatured - 5"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Field featured_until must be an RFC3339 timestamp"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+		reqEntity.Until = &until
+	}
+
+	err = ch.contentService.SetFeatured(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] SetFeatured - 6"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		switch {
+		case errors.Is(err, service.ErrContentNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrFeatureExpired):
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Content feature updated successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// ReorderFeatured implements ContentHandler.
+func (ch *contentHandler) ReorderFeatured(c *fiber.Ctx) error {
+	var req request.ReorderFeaturedRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] ReorderFeatured - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] ReorderFeatured - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] ReorderFeatured - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = ch.contentService.ReorderFeatured(c.Context(), req.ContentIDs)
+	if err != nil {
+		code = "[HANDLER] ReorderFeatured - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrContentNotFeatured) || errors.Is(err, service.ErrFeaturedDuplicated) {
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Featured contents reordered successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// SetPinned implements ContentHandler.
+func (ch *contentHandler) SetPinned(c *fiber.Ctx) error {
+	var req request.PinRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] SetPinned - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] SetPinned - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] SetPinned - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = ch.contentService.SetPinned(c.Context(), id, req.Pinned)
+	if err != nil {
+		code = "[HANDLER] SetPinned - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrContentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Content pin updated successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// SetContentAuthors implements ContentHandler.
+func (ch *contentHandler) SetContentAuthors(c *fiber.Ctx) error {
+	var req request.ContentAuthorsRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] SetContentAuthors - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] SetContentAuthors - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] SetContentAuthors - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] SetContentAuthors - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	authors := []entity.ContentAuthorEntity{}
+	for _, val := range req.Authors {
+		authors = append(authors, entity.ContentAuthorEntity{
+			User: entity.UserEntity{ID: val.UserID},
+			Role: val.Role,
+		})
+	}
+
+	err = ch.contentService.SetContentAuthors(c.Context(), id, authors)
+	if err != nil {
+		code = "[HANDLER] SetContentAuthors - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		switch {
+		case errors.Is(err, service.ErrContentNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrAuthorsRequired), errors.Is(err, service.ErrAuthorDuplicated), errors.Is(err, entity.ErrAuthorNotFound):
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Content authors updated successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// AuditContent implements ContentHandler.
+func (ch *contentHandler) AuditContent(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] AuditContent - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] AuditContent - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := ch.contentService.AuditContent(c.Context(), id)
+	if err != nil {
+		code = "[HANDLER] AuditContent - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrContentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	reportResponse := response.SeoReportResponse{
+		ContentID: result.ContentID,
+		Score:     result.Score,
+		Issues:    []response.SeoIssueResponse{},
+	}
+
+	for _, issue := range result.Issues {
+		reportResponse.Issues = append(reportResponse.Issues, response.SeoIssueResponse{
+			Field:    issue.Field,
+			Severity: issue.Severity,
+			Message:  issue.Message,
+		})
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "SEO check completed"
+	successResponseDefault.Data = reportResponse
+
+	return c.JSON(successResponseDefault)
+}
+
+// seoResponse maps the SEO fields of a content. With resolve set, empty
+// overrides are replaced by what the public site falls back to.
+func seoResponse(result entity.ContentEntity, resolve bool) *response.SeoResponse {
+	resp := response.SeoResponse{
+		MetaTitle:       result.MetaTitle,
+		MetaDescription: result.MetaDescription,
+		CanonicalUrl:    result.CanonicalUrl,
+		OgImage:         result.OgImage,
+		NoIndex:         result.NoIndex,
+	}
+
+	if result.JsonLD != "" {
+		resp.JsonLD = json.RawMessage(result.JsonLD)
+	}
+
+	if resolve {
+		if resp.MetaTitle == "" {
+			resp.MetaTitle = result.Title
+		}
+		if resp.MetaDescription == "" {
+			resp.MetaDescription = conv.SanitizeText(result.Excerpt)
+		}
+		if resp.OgImage == "" {
+			resp.OgImage = result.Image
+		}
+	}
+
+	return &resp
+}
+
+// translationResponses maps the translations of a content or category. The
+// FE only links to published contents.
+func translationResponses(translations []entity.TranslationEntity, publishedOnly bool) []response.TranslationResponse {
+	resps := []response.TranslationResponse{}
+	for _, translation := range translations {
+		if publishedOnly && translation.Status != "" && translation.Status != "PUBLISH" {
+			continue
+		}
+
+		resps = append(resps, response.TranslationResponse{
+			ID:     translation.ID,
+			Locale: translation.Locale,
+			Title:  translation.Title,
+			Slug:   translation.Slug,
+			Status: translation.Status,
+			Url:    translation.Url,
+		})
+	}
+
+	return resps
+}
+
+// tocResponse maps a stored table of contents to its response.
+func tocResponse(toc []entity.HeadingEntity) []response.TocResponse {
+	resps := []response.TocResponse{}
+	for _, heading := range toc {
+		resps = append(resps, response.TocResponse{
+			Level: heading.Level,
+			Text:  heading.Text,
+			ID:    heading.ID,
+		})
+	}
+
+	return resps
+}
+
+// versionTag is the ETag of a content or category version.
+func versionTag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ifMatchVersion reads the version an edit is based on from If-Match. It
+// reports false when the header is missing. "*" gives 0, which edits the
+// current version, and a tag that is not a version gives -1, which never
+// matches.
+func ifMatchVersion(c *fiber.Ctx) (int, bool) {
+	header := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if header == "" {
+		return 0, false
+	}
+
+	if header == "*" {
+		return 0, true
+	}
+
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(header, "W/"), `"`))
+	if err != nil || version <= 0 {
+		return -1, true
+	}
+
+	return version, true
+}
+
+// optionalTime formats t as RFC3339, or returns "" when it is unset.
+func optionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func NewContentHandler(contentService service.ContentService, viewService service.ViewService, visitor visitor.Visitor) ContentHandler {
 	return &contentHandler{
 		contentService: contentService,
+		viewService:    viewService,
+		visitor:        visitor,
 	}
 }
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type DashboardHandler interface {
+	GetDashboard(c *fiber.Ctx) error
+}
+
+type dashboardHandler struct {
+	dashboardService service.DashboardService
+}
+
+// GetDashboard implements DashboardHandler.
+func (dh *dashboardHandler) GetDashboard(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] GetDashboard - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	staleDays := 14
+	if c.Query("staleDays") != "" {
+		staleDays, err = conv.StringToInt(c.Query("staleDays"))
+		if err != nil || staleDays < 0 {
+			code = "[HANDLER] GetDashboard - 2"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid staleDays"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	interval := c.Query("interval", "week")
+	if interval != "week" && interval != "month" {
+		code = "[HANDLER] GetDashboard - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Field interval must be one of: week month"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	periods := 12
+	if c.Query("periods") != "" {
+		periods, err = conv.StringToInt(c.Query("periods"))
+		if err != nil || periods <= 0 || periods > 104 {
+			code = "[HANDLER] GetDashboard - 4"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid periods"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	reqEntity := entity.DashboardQuery{
+		StaleDays:   staleDays,
+		Interval:    interval,
+		Periods:     periods,
+		RecentLimit: 10,
+	}
+
+	result, err := dh.dashboardService.GetDashboard(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] GetDashboard - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	dashboardResponse := response.DashboardResponse{
+		TotalContents:   result.TotalContents,
+		PendingComments: result.PendingComments,
+		StatusCounts:    []response.StatusCountResponse{},
+		Categories:      []response.CategoryCountResponse{},
+		Authors:         []response.AuthorCountResponse{},
+		RecentActivity:  []response.ActivityResponse{},
+		StaleDrafts:     []response.StaleDraftResponse{},
+		Cadence:         []response.CadenceResponse{},
+		GeneratedAt:     result.GeneratedAt.Format(time.RFC3339),
+	}
+
+	for _, val := range result.StatusCounts {
+		dashboardResponse.StatusCounts = append(dashboardResponse.StatusCounts, response.StatusCountResponse{
+			Status: val.Status,
+			Total:  val.Total,
+		})
+	}
+
+	for _, val := range result.Categories {
+		dashboardResponse.Categories = append(dashboardResponse.Categories, response.CategoryCountResponse{
+			CategoryID: val.CategoryID,
+			Title:      val.Title,
+			Total:      val.Total,
+			Published:  val.Published,
+		})
+	}
+
+	for _, val := range result.Authors {
+		dashboardResponse.Authors = append(dashboardResponse.Authors, response.AuthorCountResponse{
+			UserID:    val.UserID,
+			Name:      val.Name,
+			Total:     val.Total,
+			Published: val.Published,
+		})
+	}
+
+	for _, val := range result.RecentActivity {
+		dashboardResponse.RecentActivity = append(dashboardResponse.RecentActivity, response.ActivityResponse{
+			Type:      val.Type,
+			ContentID: val.ContentID,
+			Title:     val.Title,
+			Actor:     val.Actor,
+			Status:    val.Status,
+			At:        val.At.Format(time.RFC3339),
+		})
+	}
+
+	for _, val := range result.StaleDrafts {
+		dashboardResponse.StaleDrafts = append(dashboardResponse.StaleDrafts, response.StaleDraftResponse{
+			ID:        val.ID,
+			Title:     val.Title,
+			Author:    val.User.Name,
+			UpdatedAt: val.UpdatedAt.Format(time.RFC3339),
+			AgeDays:   int(time.Since(val.UpdatedAt).Hours() / 24),
+		})
+	}
+
+	for _, val := range result.Cadence {
+		dashboardResponse.Cadence = append(dashboardResponse.Cadence, response.CadenceResponse{
+			Period: val.Period.Format(time.DateOnly),
+			Total:  val.Total,
+		})
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Dashboard fetched successfully"
+	successResponseDefault.Data = dashboardResponse
+
+	return c.JSON(successResponseDefault)
+}
+
+func NewDashboardHandler(dashboardService service.DashboardService) DashboardHandler {
+	return &dashboardHandler{
+		dashboardService: dashboardService,
+	}
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	"blog/lib/feed"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type FeedHandler interface {
+	RSS(c *fiber.Ctx) error
+	Atom(c *fiber.Ctx) error
+	JSONFeed(c *fiber.Ctx) error
+}
+
+type feedHandler struct {
+	feedService service.FeedService
+}
+
+// RSS implements FeedHandler.
+func (fh *feedHandler) RSS(c *fiber.Ctx) error {
+	return fh.render(c, feed.RSS, feed.ContentTypeRSS)
+}
+
+// Atom implements FeedHandler.
+func (fh *feedHandler) Atom(c *fiber.Ctx) error {
+	return fh.render(c, feed.Atom, feed.ContentTypeAtom)
+}
+
+// JSONFeed implements FeedHandler.
+func (fh *feedHandler) JSONFeed(c *fiber.Ctx) error {
+	return fh.render(c, feed.JSON, feed.ContentTypeJSON)
+}
+
+func (fh *feedHandler) render(c *fiber.Ctx, renderer func(*entity.FeedEntity) ([]byte, error), contentType string) error {
+	limit := 0
+	if c.Query("limit") != "" {
+		limit, err = conv.StringToInt(c.Query("limit"))
+		if err != nil {
+			code = "[HANDLER] Feed - 1"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid limit number"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	reqEntity := entity.FeedQuery{
+		CategorySlug: c.Query("category"),
+		Tag:          c.Query("tag"),
+		FullContent:  c.Query("mode") == "full",
+		Limit:        limit,
+	}
+
+	result, err := fh.feedService.GetFeed(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] Feed - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	result.FeedLink = c.BaseURL() + c.OriginalURL()
+
+	body, err := renderer(result)
+	if err != nil {
+		code = "[HANDLER] Feed - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	sum := sha1.Sum(body)
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	lastModified := result.UpdatedAt.UTC().Truncate(time.Second)
+
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	if !lastModified.IsZero() {
+		c.Set(fiber.HeaderLastModified, lastModified.Format(http.TimeFormat))
+	}
+
+	if notModified(c, etag, lastModified) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Send(body)
+}
+
+// notModified reports whether the client copy is still fresh. If-None-Match
+// takes precedence over If-Modified-Since as required by RFC 7232; it may
+// list several tags and is compared weakly, ignoring any W/ prefix.
+func notModified(c *fiber.Ctx, etag string, lastModified time.Time) bool {
+	if match := c.Get(fiber.HeaderIfNoneMatch); match != "" {
+		etag = strings.TrimPrefix(etag, "W/")
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := c.Get(fiber.HeaderIfModifiedSince); since != "" && !lastModified.IsZero() {
+		t, err := http.ParseTime(since)
+		return err == nil && !lastModified.After(t)
+	}
+
+	return false
+}
+
+func NewFeedHandler(feedService service.FeedService) FeedHandler {
+	return &feedHandler{
+		feedService: feedService,
+	}
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/request"
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type LockHandler interface {
+	AcquireLock(c *fiber.Ctx) error
+	Heartbeat(c *fiber.Ctx) error
+	ReleaseLock(c *fiber.Ctx) error
+}
+
+type lockHandler struct {
+	lockService service.LockService
+}
+
+// lockResponse maps an edit lock to its response, or nil when the content
+// is free. Mine tells the caller whether they hold it.
+func lockResponse(result *entity.ContentLockEntity, userID int64) *response.LockResponse {
+	if result == nil {
+		return nil
+	}
+
+	return &response.LockResponse{
+		ContentID:  result.ContentID,
+		UserID:     result.User.ID,
+		UserName:   result.User.Name,
+		Mine:       result.User.ID == userID,
+		AcquiredAt: result.AcquiredAt.Format(time.RFC3339),
+		ExpiresAt:  result.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+// lockConflict answers 409 with the lock currently held by someone else.
+func lockConflict(c *fiber.Ctx, err error, current *entity.ContentLockEntity, userID int64) error {
+	return c.Status(fiber.StatusConflict).JSON(response.ConflictResponse{
+		Meta: response.Meta{
+			Status:  false,
+			Message: err.Error(),
+		},
+		Current: lockResponse(current, userID),
+	})
+}
+
+// AcquireLock implements LockHandler. Acquiring a lock already held renews
+// it, so the editor can call it again after reopening a content.
+func (lh *lockHandler) AcquireLock(c *fiber.Ctx) error {
+	var req request.LockRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] AcquireLock - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] AcquireLock - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	// an empty body acquires without taking over
+	if len(c.Body()) > 0 {
+		if err = c.BodyParser(&req); err != nil {
+			code = "[HANDLER] AcquireLock - 3"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = err.Error()
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	result, err := lh.lockService.AcquireLock(c.Context(), contentID, int64(userID), req.Force)
+	if err != nil {
+		code = "[HANDLER] AcquireLock - 4"
+		log.Errorw(code, err)
+		switch {
+		case errors.Is(err, service.ErrContentLocked):
+			return lockConflict(c, err, result, int64(userID))
+		case errors.Is(err, service.ErrContentNotFound):
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = err.Error()
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Edit lock acquired successfully"
+	successResponseDefault.Data = lockResponse(result, int64(userID))
+
+	return c.JSON(successResponseDefault)
+}
+
+// Heartbeat implements LockHandler.
+func (lh *lockHandler) Heartbeat(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] Heartbeat - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] Heartbeat - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := lh.lockService.Heartbeat(c.Context(), contentID, int64(userID))
+	if err != nil {
+		code = "[HANDLER] Heartbeat - 3"
+		log.Errorw(code, err)
+		if errors.Is(err, service.ErrLockNotHeld) {
+			return lockConflict(c, err, result, int64(userID))
+		}
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Edit lock extended successfully"
+	successResponseDefault.Data = lockResponse(result, int64(userID))
+
+	return c.JSON(successResponseDefault)
+}
+
+// ReleaseLock implements LockHandler.
+func (lh *lockHandler) ReleaseLock(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] ReleaseLock - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] ReleaseLock - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = lh.lockService.ReleaseLock(c.Context(), contentID, int64(userID))
+	if err != nil {
+		code = "[HANDLER] ReleaseLock - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Edit lock released successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+func NewLockHandler(lockService service.LockService) LockHandler {
+	return &lockHandler{lockService: lockService}
+}
@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/request"
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	"blog/lib/preview"
+	validatorLib "blog/lib/validator"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type PreviewHandler interface {
+	GetPreviews(c *fiber.Ctx) error
+	CreatePreview(c *fiber.Ctx) error
+	RevokePreview(c *fiber.Ctx) error
+
+	// FE
+	GetPreviewFE(c *fiber.Ctx) error
+}
+
+type previewHandler struct {
+	previewService service.PreviewService
+}
+
+// previewResponse maps a preview link to its response.
+func previewResponse(result entity.PreviewTokenEntity) response.PreviewResponse {
+	return response.PreviewResponse{
+		ID:            result.ID,
+		ContentID:     result.ContentID,
+		Token:         result.Token,
+		Url:           result.Url,
+		Active:        result.RevokedAt == nil && time.Now().Before(result.ExpiresAt),
+		ExpiresAt:     result.ExpiresAt.Format(time.RFC3339),
+		RevokedAt:     optionalTime(result.RevokedAt),
+		CreatedAt:     result.CreatedAt.Format(time.RFC3339),
+		CreatedByName: result.User.Name,
+	}
+}
+
+// GetPreviews implements PreviewHandler.
+func (ph *previewHandler) GetPreviews(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] GetPreviews - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] GetPreviews - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	results, err := ph.previewService.GetPreviews(c.Context(), contentID)
+	if err != nil {
+		code = "[HANDLER] GetPreviews - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	previewResponses := []response.PreviewResponse{}
+	for _, result := range results {
+		previewResponses = append(previewResponses, previewResponse(result))
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Preview links fetched successfully"
+	successResponseDefault.Data = previewResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+// CreatePreview implements PreviewHandler. The token is only returned here,
+// it can't be recovered later.
+func (ph *previewHandler) CreatePreview(c *fiber.Ctx) error {
+	var req request.PreviewRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] CreatePreview - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] CreatePreview - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	// an empty body creates a link with the default lifetime
+	if len(c.Body()) > 0 {
+		if err = c.BodyParser(&req); err != nil {
+			code = "[HANDLER] CreatePreview - 3"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = err.Error()
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] CreatePreview - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := ph.previewService.CreatePreview(c.Context(), contentID, int64(userID), time.Duration(req.ExpiresIn)*time.Second)
+	if err != nil {
+		code = "[HANDLER] CreatePreview - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		switch {
+		case errors.Is(err, service.ErrContentNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		case errors.Is(err, service.ErrPreviewPublished):
+			return c.Status(fiber.StatusConflict).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Preview link created successfully"
+	successResponseDefault.Data = previewResponse(*result)
+
+	return c.Status(fiber.StatusCreated).JSON(successResponseDefault)
+}
+
+// RevokePreview implements PreviewHandler.
+func (ph *previewHandler) RevokePreview(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] RevokePreview - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] RevokePreview - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("previewId"))
+	if err != nil {
+		code = "[HANDLER] RevokePreview - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid preview id"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = ph.previewService.RevokePreview(c.Context(), contentID, id)
+	if err != nil {
+		code = "[HANDLER] RevokePreview - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrPreviewNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Preview link revoked successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetPreviewFE implements PreviewHandler. It serves the same detail as a
+// published content, without counting a view, and keeps the preview out of
+// search engines and caches.
+func (ph *previewHandler) GetPreviewFE(c *fiber.Ctx) error {
+	c.Set("X-Robots-Tag", "noindex, nofollow")
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+
+	result, err := ph.previewService.GetContentByPreview(c.Context(), c.Params("token"))
+	if err != nil {
+		code = "[HANDLER] GetPreviewFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, preview.ErrExpiredToken) {
+			return c.Status(fiber.StatusGone).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Success"
+	successResponseDefault.Data = contentDetailResponse(*result)
+
+	return c.JSON(successResponseDefault)
+}
+
+func NewPreviewHandler(previewService service.PreviewService) PreviewHandler {
+	return &previewHandler{
+		previewService: previewService,
+	}
+}
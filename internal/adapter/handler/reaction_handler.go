@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/request"
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	validatorLib "blog/lib/validator"
+	"blog/lib/visitor"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type ReactionHandler interface {
+	GetReactionsFE(c *fiber.Ctx) error
+	AddReactionFE(c *fiber.Ctx) error
+	RemoveReactionFE(c *fiber.Ctx) error
+}
+
+type reactionHandler struct {
+	reactionService service.ReactionService
+	visitor         visitor.Visitor
+}
+
+// GetReactionsFE implements ReactionHandler.
+func (rh *reactionHandler) GetReactionsFE(c *fiber.Ctx) error {
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] GetReactionsFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := rh.reactionService.GetReactions(c.Context(), contentID, rh.visitor.Hash(c))
+	if err != nil {
+		code = "[HANDLER] GetReactionsFE - 2"
+		log.Errorw(code, err)
+		return reactionError(c, err)
+	}
+
+	return reactionSuccess(c, result, "Reactions fetched successfully")
+}
+
+// AddReactionFE implements ReactionHandler.
+func (rh *reactionHandler) AddReactionFE(c *fiber.Ctx) error {
+	var req request.ReactionRequest
+
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] AddReactionFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] AddReactionFE - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] AddReactionFE - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.ReactionEntity{
+		ContentID:   contentID,
+		Reaction:    req.Reaction,
+		VisitorHash: rh.visitor.Hash(c),
+	}
+
+	result, err := rh.reactionService.AddReaction(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] AddReactionFE - 4"
+		log.Errorw(code, err)
+		return reactionError(c, err)
+	}
+
+	return reactionSuccess(c, result, "Reaction added successfully")
+}
+
+// RemoveReactionFE implements ReactionHandler.
+func (rh *reactionHandler) RemoveReactionFE(c *fiber.Ctx) error {
+	contentID, err := conv.StringToInt64(c.Params("contentId"))
+	if err != nil {
+		code = "[HANDLER] RemoveReactionFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid content ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.ReactionEntity{
+		ContentID:   contentID,
+		Reaction:    c.Params("reaction"),
+		VisitorHash: rh.visitor.Hash(c),
+	}
+
+	result, err := rh.reactionService.RemoveReaction(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] RemoveReactionFE - 2"
+		log.Errorw(code, err)
+		return reactionError(c, err)
+	}
+
+	return reactionSuccess(c, result, "Reaction removed successfully")
+}
+
+func reactionError(c *fiber.Ctx, err error) error {
+	errorResp.Meta.Status = false
+	errorResp.Meta.Message = err.Error()
+
+	switch {
+	case errors.Is(err, service.ErrReactionInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	case errors.Is(err, service.ErrReactionContentClosed):
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+}
+
+func reactionSuccess(c *fiber.Ctx, result *entity.ReactionSummaryEntity, message string) error {
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = message
+	successResponseDefault.Data = response.ReactionResponse{
+		ContentID: result.ContentID,
+		Reactions: result.Counts,
+		Total:     result.Total,
+		Mine:      result.Mine,
+	}
+
+	return c.JSON(successResponseDefault)
+}
+
+func NewReactionHandler(reactionService service.ReactionService, visitor visitor.Visitor) ReactionHandler {
+	return &reactionHandler{
+		reactionService: reactionService,
+		visitor:         visitor,
+	}
+}
@@ -1,5 +1,12 @@
 package request
 
 type CategoryRequest struct {
-	Title string `json:"title" validate:"required"`
+	Title           string `json:"title" validate:"required"`
+	Description     string `json:"description"`
+	Image           string `json:"image" validate:"omitempty,url"`
+	MetaTitle       string `json:"meta_title" validate:"max=255"`
+	MetaDescription string `json:"meta_description" validate:"max=255"`
+	DisplayOrder    int    `json:"display_order" validate:"min=0"`
+	Locale          string `json:"locale" validate:"max=10"`
+	TranslationOf   int64  `json:"translation_of" validate:"min=0"`
 }
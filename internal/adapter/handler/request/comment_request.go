@@ -0,0 +1,25 @@
+package request
+
+type CommentRequest struct {
+	Name     string `json:"name" validate:"required,max=100"`
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Body     string `json:"body" validate:"required,max=5000"`
+	ParentID int64  `json:"parent_id" validate:"min=0"`
+	Website  string `json:"website"`
+	Token    string `json:"token"`
+}
+
+type EditCommentRequest struct {
+	Name string `json:"name" validate:"max=100"`
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+type BulkCommentRequest struct {
+	IDs    []int64 `json:"ids" validate:"required,min=1"`
+	Action string  `json:"action" validate:"required,oneof=approve reject spam delete"`
+}
+
+type SpamBlocklistRequest struct {
+	Kind  string `json:"kind" validate:"required,oneof=WORD DOMAIN"`
+	Value string `json:"value" validate:"required,max=255"`
+}
@@ -1,11 +1,78 @@
 package request
 
 type ContentRequest struct {
-	Title       string   `json:"title" validate:"required"`
+	Title       string   `json:"title" validate:"required_without=TemplateID"`
 	Excerpt     string   `json:"excerpt" validate:"required"`
-	Description string   `json:"description" validate:"required"`
+	Description string   `json:"description" validate:"required_without=TemplateID"`
 	Image       string   `json:"image" validate:"required"`
 	Tags        string `json:"tags"`
 	Status      string   `json:"status" validate:"required"`
-	CategoryID  int64    `json:"category_id" validate:"required"`
+	CategoryID  int64    `json:"category_id" validate:"required_without=TemplateID"`
+
+	Locale        string `json:"locale" validate:"max=10"`
+	TranslationOf int64  `json:"translation_of" validate:"min=0"`
+	// TemplateID fills the title, description, category and tags left
+	// empty from a content template.
+	TemplateID int64 `json:"template_id" validate:"min=0"`
+	// RegenerateSlug derives a new slug from the title even when the
+	// content is already published and its links would change.
+	RegenerateSlug bool `json:"regenerate_slug"`
+
+	MetaTitle       string `json:"meta_title" validate:"max=255"`
+	MetaDescription string `json:"meta_description" validate:"max=255"`
+	CanonicalUrl    string `json:"canonical_url" validate:"omitempty,url"`
+	OgImage         string `json:"og_image" validate:"omitempty,url"`
+	NoIndex         bool   `json:"noindex"`
+	JsonLD          string `json:"json_ld" validate:"omitempty,json"`
+}
+
+type FeatureRequest struct {
+	Featured      bool   `json:"featured"`
+	Position      int    `json:"position" validate:"min=0"`
+	FeaturedUntil string `json:"featured_until"`
+}
+
+type ReorderFeaturedRequest struct {
+	ContentIDs []int64 `json:"content_ids" validate:"required,min=1"`
+}
+
+type PinRequest struct {
+	Pinned bool `json:"pinned"`
+}
+
+// ContentDraftRequest is an autosave of the working copy. Fields may be
+// incomplete until the draft is published.
+type ContentDraftRequest struct {
+	Title       string `json:"title" validate:"max=255"`
+	Excerpt     string `json:"excerpt" validate:"max=255"`
+	Description string `json:"description"`
+	Image       string `json:"image"`
+	Tags        string `json:"tags"`
+	CategoryID  int64  `json:"category_id" validate:"min=0"`
+
+	MetaTitle       string `json:"meta_title" validate:"max=255"`
+	MetaDescription string `json:"meta_description" validate:"max=255"`
+	CanonicalUrl    string `json:"canonical_url" validate:"omitempty,url"`
+	OgImage         string `json:"og_image" validate:"omitempty,url"`
+	NoIndex         bool   `json:"noindex"`
+	JsonLD          string `json:"json_ld" validate:"omitempty,json"`
+}
+
+type BulkContentRequest struct {
+	IDs        []int64  `json:"ids" validate:"required,min=1,max=100,dive,min=1"`
+	Action     string   `json:"action" validate:"required,oneof=status category add_tags remove_tags delete restore"`
+	Status     string   `json:"status" validate:"omitempty,oneof=PUBLISH DRAFT"`
+	CategoryID int64    `json:"category_id" validate:"min=0"`
+	Tags       []string `json:"tags" validate:"max=20,dive,required,max=50"`
+	DryRun     bool     `json:"dry_run"`
+}
+
+// ContentAuthorsRequest replaces the credits of a content, in credit order.
+type ContentAuthorsRequest struct {
+	Authors []ContentAuthorRequest `json:"authors" validate:"required,min=1,max=20,dive"`
+}
+
+type ContentAuthorRequest struct {
+	UserID int64  `json:"user_id" validate:"required,min=1"`
+	Role   string `json:"role" validate:"required,oneof=author editor contributor"`
 }
@@ -0,0 +1,6 @@
+package request
+
+type LockRequest struct {
+	// Force takes the lock over from another editor.
+	Force bool `json:"force"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type PreviewRequest struct {
+	// ExpiresIn is the lifetime of the link in seconds, at most 30 days.
+	ExpiresIn int `json:"expires_in" validate:"min=0,max=2592000"`
+}
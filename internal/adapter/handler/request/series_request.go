@@ -0,0 +1,10 @@
+package request
+
+type SeriesRequest struct {
+	Title       string `json:"title" validate:"required,max=255"`
+	Description string `json:"description"`
+}
+
+type SeriesContentsRequest struct {
+	ContentIDs []int64 `json:"content_ids"`
+}
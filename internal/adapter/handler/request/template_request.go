@@ -0,0 +1,9 @@
+package request
+
+type TemplateRequest struct {
+	Name         string   `json:"name" validate:"required,max=255"`
+	TitlePattern string   `json:"title_pattern" validate:"max=255"`
+	Description  string   `json:"description"`
+	CategoryID   int64    `json:"category_id" validate:"min=0"`
+	Tags         []string `json:"tags" validate:"max=20,dive,required,max=50"`
+}
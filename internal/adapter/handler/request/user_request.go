@@ -5,3 +5,13 @@ type UpdatePasswordRequest struct {
 	NewPassword     string `json:"new_password" validate:"required,min=8"`
 	ConfirmPassword string `json:"confirm_password" validate:"required,eqfield=NewPassword"`
 }
+
+// UpdateProfileRequest edits the public author profile. SocialLinks maps a
+// network to the profile URL.
+type UpdateProfileRequest struct {
+	Name        string            `json:"name" validate:"required,max=255"`
+	DisplayName string            `json:"display_name" validate:"max=255"`
+	Bio         string            `json:"bio" validate:"max=2000"`
+	SocialLinks map[string]string `json:"social_links" validate:"max=10,dive,keys,oneof=website x twitter github linkedin mastodon instagram youtube facebook,endkeys,url"`
+	Slug        string            `json:"slug" validate:"max=80"`
+}
@@ -1,8 +1,17 @@
 package response
 
 type SuccessCategoryResponse struct {
-	ID            int64  `json:"id"`
-	Title         string `json:"title"`
-	Slug          string `json:"slug"`
-	CreatedByName string `json:"created_by_name"`
+	ID              int64                 `json:"id"`
+	Title           string                `json:"title"`
+	Slug            string                `json:"slug"`
+	Description     string                `json:"description"`
+	Image           string                `json:"image"`
+	MetaTitle       string                `json:"meta_title"`
+	MetaDescription string                `json:"meta_description"`
+	DisplayOrder    int                   `json:"display_order"`
+	Locale          string                `json:"locale"`
+	Translations    []TranslationResponse `json:"translations,omitempty"`
+	PostCount       int64                 `json:"post_count"`
+	CreatedByName   string                `json:"created_by_name"`
+	Version         int                   `json:"version,omitempty"`
 }
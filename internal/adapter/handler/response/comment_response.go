@@ -0,0 +1,35 @@
+package response
+
+type SuccessCommentResponse struct {
+	ID           int64                    `json:"id"`
+	ContentID    int64                    `json:"content_id"`
+	ParentID     int64                    `json:"parent_id,omitempty"`
+	Name         string                   `json:"name"`
+	Email        string                   `json:"email,omitempty"`
+	Body         string                   `json:"body"`
+	Status       string                   `json:"status,omitempty"`
+	IPAddress    string                   `json:"ip_address,omitempty"`
+	UserAgent    string                   `json:"user_agent,omitempty"`
+	ContentTitle string                   `json:"content_title,omitempty"`
+	SpamScore    float64                  `json:"spam_score,omitempty"`
+	SpamReasons  []string                 `json:"spam_reasons,omitempty"`
+	CreatedAt    string                   `json:"created_at"`
+	Replies      []SuccessCommentResponse `json:"replies,omitempty"`
+}
+
+type BulkCommentResponse struct {
+	Action   string `json:"action"`
+	Affected int64  `json:"affected"`
+}
+
+type SpamBlocklistResponse struct {
+	ID        int64  `json:"id"`
+	Kind      string `json:"kind"`
+	Value     string `json:"value"`
+	Source    string `json:"source"`
+	CreatedAt string `json:"created_at"`
+}
+
+type CommentTokenResponse struct {
+	Token string `json:"token"`
+}
@@ -1,16 +1,126 @@
 package response
 
+import "encoding/json"
+
 type SuccessContentResponse struct {
-	ID           int64    `json:"id"`
-	Title        string   `json:"title"`
-	Excerpt      string   `json:"excerpt"`
-	Description  string   `json:"description,omitempty"`
-	Image        string   `json:"image"`
-	Tags         []string `json:"tags,omitempty"`
-	Status       string   `json:"status"`
-	CategoryID   int64    `json:"category_id,omitempty"`
-	UserID       int64    `json:"user_id,omitempty"`
-	CreatedAt    string   `json:"created_at"`
-	CategoryName string   `json:"category_name"`
-	Author       string   `json:"author"`
+	ID               int64                   `json:"id"`
+	Title            string                  `json:"title"`
+	Slug             string                  `json:"slug"`
+	Excerpt          string                  `json:"excerpt"`
+	Description      string                  `json:"description,omitempty"`
+	Image            string                  `json:"image"`
+	Tags             []string                `json:"tags,omitempty"`
+	Status           string                  `json:"status"`
+	Locale           string                  `json:"locale"`
+	Translations     []TranslationResponse   `json:"translations,omitempty"`
+	WordCount        int                     `json:"word_count"`
+	ReadingTime      int                     `json:"reading_time"`
+	Toc              []TocResponse           `json:"toc,omitempty"`
+	Seo              *SeoResponse            `json:"seo,omitempty"`
+	CategoryID       int64                   `json:"category_id,omitempty"`
+	UserID           int64                   `json:"user_id,omitempty"`
+	CreatedAt        string                  `json:"created_at"`
+	CategoryName     string                  `json:"category_name"`
+	Authors          []ContentAuthorResponse `json:"authors"`
+	CommentCount     int64                   `json:"comment_count"`
+	ReactionCount    int64                   `json:"reaction_count"`
+	Reactions        map[string]int64        `json:"reactions"`
+	ViewCount        int64                   `json:"view_count"`
+	Featured         bool                    `json:"featured"`
+	FeaturedPosition int                     `json:"featured_position,omitempty"`
+	FeaturedUntil    string                  `json:"featured_until,omitempty"`
+	Pinned           bool                    `json:"pinned"`
+	DeletedAt        string                  `json:"deleted_at,omitempty"`
+	Series           *SeriesNavResponse      `json:"series,omitempty"`
+	Version          int                     `json:"version,omitempty"`
+	Lock             *LockResponse           `json:"lock,omitempty"`
+	Draft            *ContentDraftResponse   `json:"draft,omitempty"`
+}
+
+// ContentAuthorResponse credits a user on a content, in credit order.
+type ContentAuthorResponse struct {
+	UserID int64  `json:"user_id"`
+	Name   string `json:"name"`
+	Slug   string `json:"slug,omitempty"`
+	Avatar string `json:"avatar,omitempty"`
+	Role   string `json:"role"`
+}
+
+// ContentDraftResponse is the unpublished working copy of a content. Stale
+// is set when the content was saved after the draft was started.
+type ContentDraftResponse struct {
+	BaseVersion int          `json:"base_version"`
+	Stale       bool         `json:"stale"`
+	Title       string       `json:"title"`
+	Excerpt     string       `json:"excerpt"`
+	Description string       `json:"description"`
+	Image       string       `json:"image"`
+	Tags        []string     `json:"tags"`
+	CategoryID  int64        `json:"category_id,omitempty"`
+	Seo         *SeoResponse `json:"seo"`
+	SavedAt     string       `json:"saved_at"`
+	SavedByName string       `json:"saved_by_name,omitempty"`
+}
+
+type TocResponse struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	ID    string `json:"id"`
+}
+
+type SeoResponse struct {
+	MetaTitle       string          `json:"meta_title"`
+	MetaDescription string          `json:"meta_description"`
+	CanonicalUrl    string          `json:"canonical_url"`
+	OgImage         string          `json:"og_image"`
+	NoIndex         bool            `json:"noindex"`
+	JsonLD          json.RawMessage `json:"json_ld,omitempty"`
+}
+
+type SeoIssueResponse struct {
+	Field    string `json:"field"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+type SeoReportResponse struct {
+	ContentID int64              `json:"content_id"`
+	Score     int                `json:"score"`
+	Issues    []SeoIssueResponse `json:"issues"`
+}
+
+type PopularContentResponse struct {
+	SuccessContentResponse
+	WindowViews int64 `json:"window_views"`
+}
+
+type ViewDailyResponse struct {
+	Day         string `json:"day"`
+	Views       int64  `json:"views"`
+	UniqueViews int64  `json:"unique_views"`
+}
+
+type ViewStatsResponse struct {
+	ContentID   int64               `json:"content_id"`
+	TotalViews  int64               `json:"total_views"`
+	Views       int64               `json:"views"`
+	UniqueViews int64               `json:"unique_views"`
+	Daily       []ViewDailyResponse `json:"daily"`
+}
+
+type RelatedContentResponse struct {
+	SuccessContentResponse
+	Score float64 `json:"score"`
+}
+
+type BulkResultResponse struct {
+	ID    int64  `json:"id"`
+	Ok    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+type BulkReportResponse struct {
+	Applied bool                 `json:"applied"`
+	DryRun  bool                 `json:"dry_run"`
+	Results []BulkResultResponse `json:"results"`
 }
@@ -0,0 +1,54 @@
+package response
+
+type StatusCountResponse struct {
+	Status string `json:"status"`
+	Total  int64  `json:"total"`
+}
+
+type CategoryCountResponse struct {
+	CategoryID int64  `json:"category_id"`
+	Title      string `json:"title"`
+	Total      int64  `json:"total"`
+	Published  int64  `json:"published"`
+}
+
+type AuthorCountResponse struct {
+	UserID    int64  `json:"user_id"`
+	Name      string `json:"name"`
+	Total     int64  `json:"total"`
+	Published int64  `json:"published"`
+}
+
+type ActivityResponse struct {
+	Type      string `json:"type"`
+	ContentID int64  `json:"content_id"`
+	Title     string `json:"title"`
+	Actor     string `json:"actor"`
+	Status    string `json:"status"`
+	At        string `json:"at"`
+}
+
+type StaleDraftResponse struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	Author    string `json:"author"`
+	UpdatedAt string `json:"updated_at"`
+	AgeDays   int    `json:"age_days"`
+}
+
+type CadenceResponse struct {
+	Period string `json:"period"`
+	Total  int64  `json:"total"`
+}
+
+type DashboardResponse struct {
+	TotalContents   int64                   `json:"total_contents"`
+	PendingComments int64                   `json:"pending_comments"`
+	StatusCounts    []StatusCountResponse   `json:"status_counts"`
+	Categories      []CategoryCountResponse `json:"categories"`
+	Authors         []AuthorCountResponse   `json:"authors"`
+	RecentActivity  []ActivityResponse      `json:"recent_activity"`
+	StaleDrafts     []StaleDraftResponse    `json:"stale_drafts"`
+	Cadence         []CadenceResponse       `json:"cadence"`
+	GeneratedAt     string                  `json:"generated_at"`
+}
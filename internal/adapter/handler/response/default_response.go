@@ -15,6 +15,13 @@ type SuccessResponseDefault struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
 }
 
+// ConflictResponse rejects an edit based on a stale version and carries the
+// current server copy, so the client can merge before saving again.
+type ConflictResponse struct {
+	Meta    Meta        `json:"meta"`
+	Current interface{} `json:"current"`
+}
+
 type PaginationResponse struct {
 	TotalRecords int `json:"total_records"`
 	Page         int `json:"page"`
@@ -0,0 +1,10 @@
+package response
+
+type LockResponse struct {
+	ContentID  int64  `json:"content_id"`
+	UserID     int64  `json:"user_id"`
+	UserName   string `json:"user_name"`
+	Mine       bool   `json:"mine"`
+	AcquiredAt string `json:"acquired_at"`
+	ExpiresAt  string `json:"expires_at"`
+}
@@ -0,0 +1,13 @@
+package response
+
+type PreviewResponse struct {
+	ID            int64  `json:"id"`
+	ContentID     int64  `json:"content_id"`
+	Token         string `json:"token,omitempty"`
+	Url           string `json:"url,omitempty"`
+	Active        bool   `json:"active"`
+	ExpiresAt     string `json:"expires_at"`
+	RevokedAt     string `json:"revoked_at,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	CreatedByName string `json:"created_by_name,omitempty"`
+}
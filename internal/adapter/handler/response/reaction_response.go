@@ -0,0 +1,8 @@
+package response
+
+type ReactionResponse struct {
+	ContentID int64            `json:"content_id"`
+	Reactions map[string]int64 `json:"reactions"`
+	Total     int64            `json:"total"`
+	Mine      []string         `json:"mine"`
+}
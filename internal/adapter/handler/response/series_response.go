@@ -0,0 +1,34 @@
+package response
+
+type SeriesPartResponse struct {
+	ID           int64  `json:"id"`
+	Title        string `json:"title"`
+	Slug         string `json:"slug"`
+	Excerpt      string `json:"excerpt,omitempty"`
+	Image        string `json:"image,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Part         int    `json:"part,omitempty"`
+	CategoryName string `json:"category_name,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+}
+
+type SeriesResponse struct {
+	ID            int64                `json:"id"`
+	Title         string               `json:"title"`
+	Slug          string               `json:"slug"`
+	Description   string               `json:"description"`
+	PartCount     int64                `json:"part_count"`
+	CreatedByName string               `json:"created_by_name"`
+	CreatedAt     string               `json:"created_at"`
+	Parts         []SeriesPartResponse `json:"parts,omitempty"`
+}
+
+type SeriesNavResponse struct {
+	ID       int64               `json:"id"`
+	Title    string              `json:"title"`
+	Slug     string              `json:"slug"`
+	Part     int                 `json:"part"`
+	Total    int                 `json:"total"`
+	Previous *SeriesPartResponse `json:"previous"`
+	Next     *SeriesPartResponse `json:"next"`
+}
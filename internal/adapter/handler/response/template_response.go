@@ -0,0 +1,14 @@
+package response
+
+type TemplateResponse struct {
+	ID            int64    `json:"id"`
+	Name          string   `json:"name"`
+	TitlePattern  string   `json:"title_pattern"`
+	Description   string   `json:"description"`
+	CategoryID    int64    `json:"category_id,omitempty"`
+	CategoryName  string   `json:"category_name,omitempty"`
+	Tags          []string `json:"tags"`
+	CreatedByName string   `json:"created_by_name"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+}
@@ -0,0 +1,23 @@
+package response
+
+type TranslationResponse struct {
+	ID     int64  `json:"id"`
+	Locale string `json:"locale"`
+	Title  string `json:"title"`
+	Slug   string `json:"slug"`
+	Status string `json:"status,omitempty"`
+	Url    string `json:"url"`
+}
+
+type TranslationGroupResponse struct {
+	GroupID int64    `json:"group_id"`
+	ID      int64    `json:"id"`
+	Title   string   `json:"title"`
+	Locales []string `json:"locales"`
+	Missing []string `json:"missing"`
+}
+
+type MissingTranslationsResponse struct {
+	Contents   []TranslationGroupResponse `json:"contents"`
+	Categories []TranslationGroupResponse `json:"categories"`
+}
@@ -1,7 +1,24 @@
 package response
 
 type UserResponse struct {
-	ID    int64  `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Email       string            `json:"email"`
+	DisplayName string            `json:"display_name"`
+	Bio         string            `json:"bio"`
+	Avatar      string            `json:"avatar"`
+	SocialLinks map[string]string `json:"social_links"`
+	Slug        string            `json:"slug"`
+}
+
+// AuthorResponse is the public profile of a user. It must never carry the
+// email address.
+type AuthorResponse struct {
+	ID          int64                    `json:"id"`
+	Name        string                   `json:"name"`
+	Slug        string                   `json:"slug"`
+	Bio         string                   `json:"bio"`
+	Avatar      string                   `json:"avatar"`
+	SocialLinks map[string]string        `json:"social_links"`
+	Contents    []SuccessContentResponse `json:"contents"`
 }
@@ -0,0 +1,419 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/request"
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	validatorLib "blog/lib/validator"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type SeriesHandler interface {
+	GetSeries(c *fiber.Ctx) error
+	GetSeriesByID(c *fiber.Ctx) error
+	CreateSeries(c *fiber.Ctx) error
+	EditSeriesByID(c *fiber.Ctx) error
+	DeleteSeries(c *fiber.Ctx) error
+	SetSeriesContents(c *fiber.Ctx) error
+
+	GetSeriesFE(c *fiber.Ctx) error
+	GetSeriesBySlugFE(c *fiber.Ctx) error
+}
+
+type seriesHandler struct {
+	seriesService service.SeriesService
+}
+
+// GetSeries implements SeriesHandler.
+func (sh *seriesHandler) GetSeries(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] GetSeries - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	results, err := sh.seriesService.GetSeries(c.Context())
+	if err != nil {
+		code = "[HANDLER] GetSeries - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	seriesResponses := []response.SeriesResponse{}
+	for _, result := range results {
+		seriesResponses = append(seriesResponses, seriesResponse(result))
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Series fetched successfully"
+	successResponseDefault.Data = seriesResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetSeriesByID implements SeriesHandler.
+func (sh *seriesHandler) GetSeriesByID(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] GetSeriesByID - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("seriesId"))
+	if err != nil {
+		code = "[HANDLER] GetSeriesByID - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid series ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := sh.seriesService.GetSeriesByID(c.Context(), id)
+	if err != nil {
+		code = "[HANDLER] GetSeriesByID - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Series fetched successfully"
+	successResponseDefault.Data = seriesResponse(*result)
+
+	return c.JSON(successResponseDefault)
+}
+
+// CreateSeries implements SeriesHandler.
+func (sh *seriesHandler) CreateSeries(c *fiber.Ctx) error {
+	var req request.SeriesRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] CreateSeries - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] CreateSeries - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] CreateSeries - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.SeriesEntity{
+		Title:       req.Title,
+		Description: req.Description,
+		UserID:      int64(userID),
+	}
+
+	id, err := sh.seriesService.CreateSeries(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] CreateSeries - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Series created successfully"
+	successResponseDefault.Data = map[string]int64{"id": id}
+
+	return c.Status(fiber.StatusCreated).JSON(successResponseDefault)
+}
+
+// EditSeriesByID implements SeriesHandler.
+func (sh *seriesHandler) EditSeriesByID(c *fiber.Ctx) error {
+	var req request.SeriesRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] EditSeriesByID - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("seriesId"))
+	if err != nil {
+		code = "[HANDLER] EditSeriesByID - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid series ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] EditSeriesByID - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] EditSeriesByID - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.SeriesEntity{
+		ID:          id,
+		Title:       req.Title,
+		Description: req.Description,
+	}
+
+	err = sh.seriesService.EditSeriesByID(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] EditSeriesByID - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Series updated successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// DeleteSeries implements SeriesHandler.
+func (sh *seriesHandler) DeleteSeries(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] DeleteSeries - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("seriesId"))
+	if err != nil {
+		code = "[HANDLER] DeleteSeries - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid series ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = sh.seriesService.DeleteSeries(c.Context(), id)
+	if err != nil {
+		code = "[HANDLER] DeleteSeries - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Series deleted successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// SetSeriesContents implements SeriesHandler.
+func (sh *seriesHandler) SetSeriesContents(c *fiber.Ctx) error {
+	var req request.SeriesContentsRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] SetSeriesContents - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("seriesId"))
+	if err != nil {
+		code = "[HANDLER] SetSeriesContents - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid series ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] SetSeriesContents - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = sh.seriesService.SetSeriesContents(c.Context(), id, req.ContentIDs)
+	if err != nil {
+		code = "[HANDLER] SetSeriesContents - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrSeriesDuplicatePart) || errors.Is(err, service.ErrSeriesInvalidPart) {
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Series contents updated successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetSeriesFE implements SeriesHandler.
+func (sh *seriesHandler) GetSeriesFE(c *fiber.Ctx) error {
+	results, err := sh.seriesService.GetSeriesFE(c.Context())
+	if err != nil {
+		code = "[HANDLER] GetSeriesFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	seriesResponses := []response.SeriesResponse{}
+	for _, result := range results {
+		seriesResponses = append(seriesResponses, seriesResponse(result))
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Series fetched successfully"
+	successResponseDefault.Data = seriesResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetSeriesBySlugFE implements SeriesHandler.
+func (sh *seriesHandler) GetSeriesBySlugFE(c *fiber.Ctx) error {
+	slug := c.Params("slug")
+	if slug == "" {
+		code = "[HANDLER] GetSeriesBySlugFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid series slug"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := sh.seriesService.GetSeriesBySlugFE(c.Context(), slug)
+	if err != nil || result.PartCount == 0 {
+		code = "[HANDLER] GetSeriesBySlugFE - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Series not found"
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Series fetched successfully"
+	successResponseDefault.Data = seriesResponse(*result)
+
+	return c.JSON(successResponseDefault)
+}
+
+func seriesResponse(result entity.SeriesEntity) response.SeriesResponse {
+	resp := response.SeriesResponse{
+		ID:            result.ID,
+		Title:         result.Title,
+		Slug:          result.Slug,
+		Description:   result.Description,
+		PartCount:     result.PartCount,
+		CreatedByName: result.User.Name,
+		CreatedAt:     result.CreatedAt.Format(time.RFC3339),
+	}
+
+	for i, part := range result.Parts {
+		resp.Parts = append(resp.Parts, response.SeriesPartResponse{
+			ID:           part.ID,
+			Title:        part.Title,
+			Slug:         part.Slug,
+			Excerpt:      part.Excerpt,
+			Image:        part.Image,
+			Status:       part.Status,
+			Part:         i + 1,
+			CategoryName: part.Category.Title,
+			CreatedAt:    part.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return resp
+}
+
+func seriesNavResponse(nav *entity.SeriesNavEntity) *response.SeriesNavResponse {
+	if nav == nil {
+		return nil
+	}
+
+	resp := response.SeriesNavResponse{
+		ID:    nav.ID,
+		Title: nav.Title,
+		Slug:  nav.Slug,
+		Part:  nav.Part,
+		Total: nav.Total,
+	}
+
+	if nav.Previous != nil {
+		resp.Previous = &response.SeriesPartResponse{ID: nav.Previous.ID, Title: nav.Previous.Title, Slug: nav.Previous.Slug}
+	}
+	if nav.Next != nil {
+		resp.Next = &response.SeriesPartResponse{ID: nav.Next.ID, Title: nav.Next.Title, Slug: nav.Next.Slug}
+	}
+
+	return &resp
+}
+
+func NewSeriesHandler(seriesService service.SeriesService) SeriesHandler {
+	return &seriesHandler{
+		seriesService: seriesService,
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type SitemapHandler interface {
+	GetSitemapIndex(c *fiber.Ctx) error
+	GetSitemap(c *fiber.Ctx) error
+}
+
+type sitemapHandler struct {
+	sitemapService service.SitemapService
+}
+
+// GetSitemapIndex implements SitemapHandler.
+func (sh *sitemapHandler) GetSitemapIndex(c *fiber.Ctx) error {
+	body, err := sh.sitemapService.GetSitemapIndex(c.Context())
+	if err != nil {
+		code = "[HANDLER] GetSitemapIndex - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationXMLCharsetUTF8)
+	return c.Send(body)
+}
+
+// GetSitemap implements SitemapHandler.
+func (sh *sitemapHandler) GetSitemap(c *fiber.Ctx) error {
+	page, err := conv.StringToInt(c.Params("page"))
+	if err != nil {
+		code = "[HANDLER] GetSitemap - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid page number"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	body, err := sh.sitemapService.GetSitemap(c.Context(), c.Params("type"), page)
+	if err != nil {
+		code = "[HANDLER] GetSitemap - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+
+		if errors.Is(err, service.ErrSitemapNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationXMLCharsetUTF8)
+	return c.Send(body)
+}
+
+func NewSitemapHandler(sitemapService service.SitemapService) SitemapHandler {
+	return &sitemapHandler{
+		sitemapService: sitemapService,
+	}
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/request"
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	validatorLib "blog/lib/validator"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type SpamHandler interface {
+	GetBlocklist(c *fiber.Ctx) error
+	CreateBlocklist(c *fiber.Ctx) error
+	DeleteBlocklist(c *fiber.Ctx) error
+
+	// FE
+	GetTokenFE(c *fiber.Ctx) error
+}
+
+type spamHandler struct {
+	spamService service.SpamService
+}
+
+// GetTokenFE implements SpamHandler.
+func (sh *spamHandler) GetTokenFE(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, "no-store")
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Token issued successfully"
+	successResponseDefault.Data = response.CommentTokenResponse{
+		Token: sh.spamService.IssueToken(),
+	}
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetBlocklist implements SpamHandler.
+func (sh *spamHandler) GetBlocklist(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] GetBlocklist - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	results, err := sh.spamService.GetBlocklist(c.Context())
+	if err != nil {
+		code = "[HANDLER] GetBlocklist - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	blocklistResponses := []response.SpamBlocklistResponse{}
+	for _, result := range results {
+		blocklistResponse := response.SpamBlocklistResponse{
+			ID:        result.ID,
+			Kind:      result.Kind,
+			Value:     result.Value,
+			Source:    result.Source,
+			CreatedAt: result.CreatedAt.Format(time.RFC3339),
+		}
+
+		blocklistResponses = append(blocklistResponses, blocklistResponse)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Blocklist fetched successfully"
+	successResponseDefault.Data = blocklistResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+// CreateBlocklist implements SpamHandler.
+func (sh *spamHandler) CreateBlocklist(c *fiber.Ctx) error {
+	var req request.SpamBlocklistRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] CreateBlocklist - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] CreateBlocklist - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] CreateBlocklist - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.SpamBlocklistEntity{
+		Kind:  req.Kind,
+		Value: req.Value,
+	}
+
+	err = sh.spamService.CreateBlocklist(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] CreateBlocklist - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Blocklist entry created successfully"
+
+	return c.Status(fiber.StatusCreated).JSON(successResponseDefault)
+}
+
+// DeleteBlocklist implements SpamHandler.
+func (sh *spamHandler) DeleteBlocklist(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+	if userID == 0 {
+		code = "[HANDLER] DeleteBlocklist - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("blocklistId"))
+	if err != nil {
+		code = "[HANDLER] DeleteBlocklist - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid blocklist ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = sh.spamService.DeleteBlocklist(c.Context(), id)
+	if err != nil {
+		code = "[HANDLER] DeleteBlocklist - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Blocklist entry deleted successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+func NewSpamHandler(spamService service.SpamService) SpamHandler {
+	return &spamHandler{
+		spamService: spamService,
+	}
+}
@@ -0,0 +1,287 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/request"
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	validatorLib "blog/lib/validator"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type TemplateHandler interface {
+	GetTemplates(c *fiber.Ctx) error
+	GetTemplateByID(c *fiber.Ctx) error
+	CreateTemplate(c *fiber.Ctx) error
+	EditTemplateByID(c *fiber.Ctx) error
+	DeleteTemplate(c *fiber.Ctx) error
+}
+
+type templateHandler struct {
+	templateService service.TemplateService
+}
+
+func templateResponse(result entity.ContentTemplateEntity) response.TemplateResponse {
+	return response.TemplateResponse{
+		ID:            result.ID,
+		Name:          result.Name,
+		TitlePattern:  result.TitlePattern,
+		Description:   result.Description,
+		CategoryID:    result.CategoryID,
+		CategoryName:  result.Category.Title,
+		Tags:          result.Tags,
+		CreatedByName: result.User.Name,
+		CreatedAt:     result.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     result.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// GetTemplates implements TemplateHandler.
+func (th *templateHandler) GetTemplates(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] GetTemplates - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	results, err := th.templateService.GetTemplates(c.Context())
+	if err != nil {
+		code = "[HANDLER] GetTemplates - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	templateResponses := []response.TemplateResponse{}
+	for _, result := range results {
+		templateResponses = append(templateResponses, templateResponse(result))
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Templates fetched successfully"
+	successResponseDefault.Data = templateResponses
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetTemplateByID implements TemplateHandler.
+func (th *templateHandler) GetTemplateByID(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] GetTemplateByID - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("templateId"))
+	if err != nil {
+		code = "[HANDLER] GetTemplateByID - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid template ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	result, err := th.templateService.GetTemplateByID(c.Context(), id)
+	if err != nil {
+		code = "[HANDLER] GetTemplateByID - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusNotFound).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Template fetched successfully"
+	successResponseDefault.Data = templateResponse(*result)
+
+	return c.JSON(successResponseDefault)
+}
+
+// CreateTemplate implements TemplateHandler.
+func (th *templateHandler) CreateTemplate(c *fiber.Ctx) error {
+	var req request.TemplateRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] CreateTemplate - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] CreateTemplate - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] CreateTemplate - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.ContentTemplateEntity{
+		Name:         req.Name,
+		TitlePattern: req.TitlePattern,
+		Description:  req.Description,
+		CategoryID:   req.CategoryID,
+		Tags:         req.Tags,
+		User: entity.UserEntity{
+			ID: int64(userID),
+		},
+	}
+
+	id, err := th.templateService.CreateTemplate(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] CreateTemplate - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Template created successfully"
+	successResponseDefault.Data = map[string]int64{"id": id}
+
+	return c.Status(fiber.StatusCreated).JSON(successResponseDefault)
+}
+
+// EditTemplateByID implements TemplateHandler.
+func (th *templateHandler) EditTemplateByID(c *fiber.Ctx) error {
+	var req request.TemplateRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] EditTemplateByID - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("templateId"))
+	if err != nil {
+		code = "[HANDLER] EditTemplateByID - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid template ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = c.BodyParser(&req); err != nil {
+		code = "[HANDLER] EditTemplateByID - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err = validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] EditTemplateByID - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	reqEntity := entity.ContentTemplateEntity{
+		ID:           id,
+		Name:         req.Name,
+		TitlePattern: req.TitlePattern,
+		Description:  req.Description,
+		CategoryID:   req.CategoryID,
+		Tags:         req.Tags,
+	}
+
+	err = th.templateService.EditTemplateByID(c.Context(), reqEntity)
+	if err != nil {
+		code = "[HANDLER] EditTemplateByID - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrTemplateNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Template updated successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+// DeleteTemplate implements TemplateHandler.
+func (th *templateHandler) DeleteTemplate(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] DeleteTemplate - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	id, err := conv.StringToInt64(c.Params("templateId"))
+	if err != nil {
+		code = "[HANDLER] DeleteTemplate - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid template ID"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	err = th.templateService.DeleteTemplate(c.Context(), id)
+	if err != nil {
+		code = "[HANDLER] DeleteTemplate - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Data = nil
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Template deleted successfully"
+
+	return c.JSON(successResponseDefault)
+}
+
+func NewTemplateHandler(templateService service.TemplateService) TemplateHandler {
+	return &templateHandler{
+		templateService: templateService,
+	}
+}
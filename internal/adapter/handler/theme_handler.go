@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"blog/internal/adapter/theme"
+	"blog/lib/conv"
+	"errors"
+	"net/url"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type ThemeHandler interface {
+	Home(c *fiber.Ctx) error
+	Category(c *fiber.Ctx) error
+	Tag(c *fiber.Ctx) error
+	Post(c *fiber.Ctx) error
+	Preview(c *fiber.Ctx) error
+	Search(c *fiber.Ctx) error
+}
+
+type themeHandler struct {
+	site theme.Site
+}
+
+// Home implements ThemeHandler.
+func (th *themeHandler) Home(c *fiber.Ctx) error {
+	page, err := pageParam(c)
+	if err != nil {
+		return th.renderError(c, theme.ErrNotFound)
+	}
+
+	c.Type("html", "utf-8")
+	return th.renderError(c, th.site.Home(c.Context(), c, page))
+}
+
+// Category implements ThemeHandler.
+func (th *themeHandler) Category(c *fiber.Ctx) error {
+	page, err := pageParam(c)
+	if err != nil {
+		return th.renderError(c, theme.ErrNotFound)
+	}
+
+	c.Type("html", "utf-8")
+	return th.renderError(c, th.site.Category(c.Context(), c, pathParam(c, "slug"), page))
+}
+
+// Tag implements ThemeHandler.
+func (th *themeHandler) Tag(c *fiber.Ctx) error {
+	page, err := pageParam(c)
+	if err != nil {
+		return th.renderError(c, theme.ErrNotFound)
+	}
+
+	c.Type("html", "utf-8")
+	return th.renderError(c, th.site.Tag(c.Context(), c, pathParam(c, "tag"), page))
+}
+
+// Post implements ThemeHandler.
+func (th *themeHandler) Post(c *fiber.Ctx) error {
+	c.Type("html", "utf-8")
+	return th.renderError(c, th.site.Post(c.Context(), c, pathParam(c, "slug")))
+}
+
+// Preview implements ThemeHandler.
+func (th *themeHandler) Preview(c *fiber.Ctx) error {
+	c.Set("X-Robots-Tag", "noindex, nofollow")
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	c.Type("html", "utf-8")
+	return th.renderError(c, th.site.Preview(c.Context(), c, c.Params("token")))
+}
+
+// Search implements ThemeHandler.
+func (th *themeHandler) Search(c *fiber.Ctx) error {
+	page := 1
+	if c.Query("page") != "" {
+		page, err = conv.StringToInt(c.Query("page"))
+		if err != nil {
+			return th.renderError(c, theme.ErrNotFound)
+		}
+	}
+
+	c.Type("html", "utf-8")
+	return th.renderError(c, th.site.Search(c.Context(), c, c.Query("q"), page))
+}
+
+func (th *themeHandler) renderError(c *fiber.Ctx, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, theme.ErrNotFound) {
+		return c.Status(fiber.StatusNotFound).SendString("Page not found")
+	}
+
+	code = "[HANDLER] Theme - 1"
+	log.Errorw(code, err)
+	return c.Status(fiber.StatusInternalServerError).SendString("Internal server error")
+}
+
+// pathParam returns a route parameter with percent-encoding removed, as slugs
+// and tags may contain non-ASCII letters.
+func pathParam(c *fiber.Ctx, key string) string {
+	value, err := url.PathUnescape(c.Params(key))
+	if err != nil {
+		return c.Params(key)
+	}
+
+	return value
+}
+
+// pageParam reads the optional /page/:page segment of a listing.
+func pageParam(c *fiber.Ctx) (int, error) {
+	if c.Params("page") == "" {
+		return 1, nil
+	}
+
+	return conv.StringToInt(c.Params("page"))
+}
+
+func NewThemeHandler(site theme.Site) ThemeHandler {
+	return &themeHandler{
+		site: site,
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"blog/internal/adapter/handler/response"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type TranslationHandler interface {
+	GetMissingTranslations(c *fiber.Ctx) error
+}
+
+type translationHandler struct {
+	translationService service.TranslationService
+}
+
+// translationGroupResponses maps translation groups to their response.
+func translationGroupResponses(groups []entity.TranslationGroupEntity) []response.TranslationGroupResponse {
+	resps := []response.TranslationGroupResponse{}
+	for _, group := range groups {
+		resps = append(resps, response.TranslationGroupResponse{
+			GroupID: group.GroupID,
+			ID:      group.ID,
+			Title:   group.Title,
+			Locales: group.Locales,
+			Missing: group.Missing,
+		})
+	}
+
+	return resps
+}
+
+// GetMissingTranslations implements TranslationHandler.
+func (th *translationHandler) GetMissingTranslations(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := claims.UserID
+
+	if userID == 0 {
+		code = "[HANDLER] GetMissingTranslations - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	result, err := th.translationService.GetMissingTranslations(c.Context(), c.Query("locale"))
+	if err != nil {
+		code = "[HANDLER] GetMissingTranslations - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, service.ErrLocaleNotSupported) {
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Missing translations fetched successfully"
+	successResponseDefault.Data = response.MissingTranslationsResponse{
+		Contents:   translationGroupResponses(result.Contents),
+		Categories: translationGroupResponses(result.Categories),
+	}
+
+	return c.JSON(successResponseDefault)
+}
+
+func NewTranslationHandler(translationService service.TranslationService) TranslationHandler {
+	return &translationHandler{
+		translationService: translationService,
+	}
+}
@@ -5,7 +5,12 @@ import (
 	"blog/internal/adapter/handler/response"
 	"blog/internal/core/domain/entity"
 	"blog/internal/core/service"
+	"blog/lib/conv"
 	validatorLib "blog/lib/validator"
+	"errors"
+	"fmt"
+	"os"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
@@ -14,10 +19,16 @@ import (
 type UserHandler interface {
 	UpdatePassword(c *fiber.Ctx) error
 	GetUserById(c *fiber.Ctx) error
+	UpdateProfile(c *fiber.Ctx) error
+	UploadAvatar(c *fiber.Ctx) error
+
+	// FE
+	GetAuthorBySlugFE(c *fiber.Ctx) error
 }
 
 type userHandler struct {
-	userService service.UserService
+	userService    service.UserService
+	contentService service.ContentService
 }
 
 // GetUserById implements UserHandler.
@@ -42,15 +53,9 @@ func (u *userHandler) GetUserById(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
 	}
 
-	resp := response.UserResponse{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-	}
-
 	successResponseDefault.Meta.Status = true
 	successResponseDefault.Meta.Message = "Success"
-	successResponseDefault.Data = resp
+	successResponseDefault.Data = userResponse(user)
 
 	return c.JSON(successResponseDefault)
 }
@@ -101,8 +106,253 @@ func (u *userHandler) UpdatePassword(c *fiber.Ctx) error {
 	return c.JSON(successResponseDefault)
 }
 
-func NewUserHandler(userService service.UserService) UserHandler {
+// UpdateProfile implements UserHandler.
+func (u *userHandler) UpdateProfile(c *fiber.Ctx) error {
+	var req request.UpdateProfileRequest
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := int64(claims.UserID)
+
+	if userID == 0 {
+		code = "[HANDLER] UpdateProfile - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	if err := c.BodyParser(&req); err != nil {
+		code = "[HANDLER] UpdateProfile - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request payload"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	if err := validatorLib.ValidateStruct(req); err != nil {
+		code = "[HANDLER] UpdateProfile - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	user, err := u.userService.UpdateProfile(c.Context(), entity.UserEntity{
+		ID:          userID,
+		Name:        req.Name,
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+		SocialLinks: req.SocialLinks,
+		Slug:        req.Slug,
+	})
+	if err != nil {
+		code = "[HANDLER] UpdateProfile - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Profile updated successfully"
+	successResponseDefault.Data = userResponse(user)
+
+	return c.JSON(successResponseDefault)
+}
+
+// UploadAvatar implements UserHandler.
+func (u *userHandler) UploadAvatar(c *fiber.Ctx) error {
+	claims := c.Locals("user").(*entity.JwtData)
+	userID := int64(claims.UserID)
+
+	if userID == 0 {
+		code = "[HANDLER] UploadAvatar - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Unauthorized access"
+		return c.Status(fiber.StatusUnauthorized).JSON(errorResp)
+	}
+
+	file, err := c.FormFile("avatar")
+	if err != nil {
+		code = "[HANDLER] UploadAvatar - 2"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid request body"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	path := fmt.Sprintf("./temp/content/%s", file.Filename)
+	if err = c.SaveFile(file, path); err != nil {
+		code = "[HANDLER] UploadAvatar - 3"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+	defer os.Remove(path)
+
+	avatarUrl, err := u.userService.UploadAvatar(c.Context(), userID, entity.FileUploadEntity{
+		Name: fmt.Sprintf("avatar-%d-%d", userID, time.Now().UnixNano()),
+		Path: path,
+	})
+	if err != nil {
+		code = "[HANDLER] UploadAvatar - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	successResponseDefault.Pagination = nil
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Avatar uploaded successfully"
+	successResponseDefault.Data = map[string]interface{}{
+		"avatar": avatarUrl,
+	}
+
+	return c.JSON(successResponseDefault)
+}
+
+// GetAuthorBySlugFE implements UserHandler. The author's published contents
+// are paginated like GetContentWithQuery.
+func (u *userHandler) GetAuthorBySlugFE(c *fiber.Ctx) error {
+	slug := c.Params("slug")
+	if slug == "" {
+		code = "[HANDLER] GetAuthorBySlugFE - 1"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = "Invalid author slug"
+		return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+	}
+
+	page := 1
+	if c.Query("page") != "" {
+		page, err = conv.StringToInt(c.Query("page"))
+		if err != nil || page <= 0 {
+			code = "[HANDLER] GetAuthorBySlugFE - 2"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid page number"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	limit := 6
+	if c.Query("limit") != "" {
+		limit, err = conv.StringToInt(c.Query("limit"))
+		if err != nil || limit <= 0 {
+			code = "[HANDLER] GetAuthorBySlugFE - 3"
+			log.Errorw(code, err)
+			errorResp.Meta.Status = false
+			errorResp.Meta.Message = "Invalid limit number"
+			return c.Status(fiber.StatusBadRequest).JSON(errorResp)
+		}
+	}
+
+	orderBy := "created_at"
+	if c.Query("orderBy") != "" {
+		orderBy = c.Query("orderBy")
+	}
+
+	orderType := "desc"
+	if c.Query("orderType") != "" {
+		orderType = c.Query("orderType")
+	}
+
+	user, err := u.userService.GetUserBySlug(c.Context(), slug)
+	if err != nil {
+		code = "[HANDLER] GetAuthorBySlugFE - 4"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		if errors.Is(err, entity.ErrAuthorNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(errorResp)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	results, totalData, totalPages, err := u.contentService.GetContents(c.Context(), entity.QueryString{
+		Limit:     limit,
+		Page:      page,
+		OrderBy:   orderBy,
+		OrderType: orderType,
+		Status:    "PUBLISH",
+		AuthorID:  user.ID,
+		Locale:    c.Query("lang"),
+	})
+	if err != nil {
+		code = "[HANDLER] GetAuthorBySlugFE - 5"
+		log.Errorw(code, err)
+		errorResp.Meta.Status = false
+		errorResp.Meta.Message = err.Error()
+		return c.Status(fiber.StatusInternalServerError).JSON(errorResp)
+	}
+
+	// the email stays private, so the profile is mapped field by field
+	resp := response.AuthorResponse{
+		ID:          user.ID,
+		Name:        authorName(*user),
+		Slug:        user.Slug,
+		Bio:         user.Bio,
+		Avatar:      user.Avatar,
+		SocialLinks: user.SocialLinks,
+		Contents:    []response.SuccessContentResponse{},
+	}
+
+	for _, result := range results {
+		resp.Contents = append(resp.Contents, response.SuccessContentResponse{
+			ID:            result.ID,
+			Title:         result.Title,
+			Slug:          result.Slug,
+			Excerpt:       result.Excerpt,
+			Image:         result.Image,
+			Tags:          result.Tags,
+			Status:        result.Status,
+			Locale:        result.Locale,
+			WordCount:     result.WordCount,
+			ReadingTime:   result.ReadingTime,
+			CategoryID:    result.CategoryID,
+			CreatedAt:     result.CreatedAt.Format(time.RFC3339),
+			CategoryName:  result.Category.Title,
+			Authors:       authorResponses(result),
+			CommentCount:  result.CommentCount,
+			ReactionCount: result.ReactionCount,
+			Reactions:     result.Reactions,
+			ViewCount:     result.ViewCount,
+		})
+	}
+
+	successResponseDefault.Pagination = &response.PaginationResponse{
+		TotalRecords: int(totalData),
+		Page:         page,
+		PerPage:      limit,
+		TotalPages:   int(totalPages),
+	}
+	successResponseDefault.Meta.Status = true
+	successResponseDefault.Meta.Message = "Author fetched successfully"
+	successResponseDefault.Data = resp
+
+	return c.JSON(successResponseDefault)
+}
+
+// userResponse maps the signed in user's own profile, email included.
+func userResponse(user *entity.UserEntity) response.UserResponse {
+	return response.UserResponse{
+		ID:          user.ID,
+		Name:        user.Name,
+		Email:       user.Email,
+		DisplayName: user.DisplayName,
+		Bio:         user.Bio,
+		Avatar:      user.Avatar,
+		SocialLinks: user.SocialLinks,
+		Slug:        user.Slug,
+	}
+}
+
+func NewUserHandler(userService service.UserService, contentService service.ContentService) UserHandler {
 	return &userHandler{
-		userService: userService,
+		userService:    userService,
+		contentService: contentService,
 	}
 }
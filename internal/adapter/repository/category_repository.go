@@ -5,7 +5,6 @@ import (
 	"blog/internal/core/domain/model"
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/gofiber/fiber/v2/log"
 	"gorm.io/gorm"
@@ -13,7 +12,9 @@ import (
 
 type CategoryRepository interface {
 	GetCategories(ctx context.Context) ([]entity.CategoryEntity, error)
+	GetCategoriesByLocale(ctx context.Context, locale, fallback string) ([]entity.CategoryEntity, error)
 	GetCategoryByID(ctx context.Context, id int64) (*entity.CategoryEntity, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*entity.CategoryEntity, error)
 	CreateCategory(ctx context.Context, req entity.CategoryEntity) error
 	EditCategoryByID(ctx context.Context, req entity.CategoryEntity) error
 	DeleteCategory(ctx context.Context, id int64) error
@@ -23,29 +24,39 @@ type categoryRepository struct {
 	db *gorm.DB
 }
 
+// withPostCount selects categories together with their number of published contents.
+func (c *categoryRepository) withPostCount() *gorm.DB {
+	return c.db.Model(&model.Category{}).
+		Select("categories.*, (SELECT COUNT(*) FROM contents WHERE contents.category_id = categories.id AND contents.status = ? AND contents.deleted_at IS NULL) AS post_count", "PUBLISH")
+}
+
 // CreateCategory implements CategoryRepository.
 func (c *categoryRepository) CreateCategory(ctx context.Context, req entity.CategoryEntity) error {
-	var countSlug int64
-	err = c.db.Table("categories").Where("slug LIKE ?", req.Slug+"%").Count(&countSlug).Error
-	if err != nil {
-		code = "[REPOSITORY] CreateCategory - 2"
-		log.Errorw(code, err)
-		return err
-	}
-
-	slug := req.Slug
-
-	if countSlug > 0 {
-		slug = fmt.Sprintf("%s-%d", req.Slug, countSlug)
-	}
-
 	modelCategory := model.Category{
-		Title:  req.Title,
-		Slug:   slug,
-		UserID: req.User.ID,
+		Title:              req.Title,
+		Description:        req.Description,
+		Image:              req.Image,
+		MetaTitle:          req.MetaTitle,
+		MetaDescription:    req.MetaDescription,
+		DisplayOrder:       req.DisplayOrder,
+		Locale:             req.Locale,
+		TranslationGroupID: req.TranslationGroupID,
+		UserID:             req.User.ID,
 	}
 
-	err = c.db.Create(&modelCategory).Error
+	err = writeWithSlug(c.db, "categories", req.Slug, 0, func(slug string) error {
+		modelCategory.ID = 0
+		modelCategory.Slug = slug
+		return c.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(&modelCategory).Error; err != nil {
+				return err
+			}
+			if modelCategory.TranslationGroupID != 0 {
+				return nil
+			}
+			return tx.Model(&modelCategory).Update("translation_group_id", modelCategory.ID).Error
+		})
+	})
 	if err != nil {
 		code = "[REPOSITORY] CreateCategory - 1"
 		log.Errorw(code, err)
@@ -81,33 +92,38 @@ func (c *categoryRepository) DeleteCategory(ctx context.Context, id int64) error
 
 // EditCategoryByID implements CategoryRepository.
 func (c *categoryRepository) EditCategoryByID(ctx context.Context, req entity.CategoryEntity) error {
-	var countSlug int64
-	err = c.db.Table("categories").Where("slug LIKE ?", req.Slug+"%").Count(&countSlug).Error
-	if err != nil {
-		code = "[REPOSITORY] EditCategoryByID - 1"
-		log.Errorw(code, err)
-		return err
-	}
-
-	slug := req.Slug
-
-	if countSlug > 0 {
-		slug = fmt.Sprintf("%s-%d", req.Slug, countSlug)
-	}
-
 	modelCategory := model.Category{
-		Title:  req.Title,
-		Slug:   slug,
-		UserID: req.User.ID,
+		Title:              req.Title,
+		Description:        req.Description,
+		Image:              req.Image,
+		MetaTitle:          req.MetaTitle,
+		MetaDescription:    req.MetaDescription,
+		DisplayOrder:       req.DisplayOrder,
+		Locale:             req.Locale,
+		TranslationGroupID: req.TranslationGroupID,
+		Version:            req.Version + 1,
+		UserID:             req.User.ID,
 	}
 
-	err = c.db.Where("id = ?", req.ID).Updates(&modelCategory).Error
+	var rowsAffected int64
+	err = writeWithSlug(c.db, "categories", req.Slug, req.ID, func(slug string) error {
+		modelCategory.Slug = slug
+		result := c.db.Where("id = ? AND version = ?", req.ID, req.Version).
+			Select("title", "slug", "description", "image", "meta_title", "meta_description", "display_order", "locale", "translation_group_id", "user_id", "version").
+			Updates(&modelCategory)
+		rowsAffected = result.RowsAffected
+		return result.Error
+	})
 	if err != nil {
-		code = "[REPOSITORY] EditCategoryByID - 2"
+		code = "[REPOSITORY] EditCategoryByID - 1"
 		log.Errorw(code, err)
 		return err
 	}
 
+	if rowsAffected == 0 {
+		return entity.ErrVersionConflict
+	}
+
 	return nil
 }
 
@@ -115,7 +131,7 @@ func (c *categoryRepository) EditCategoryByID(ctx context.Context, req entity.Ca
 func (c *categoryRepository) GetCategories(ctx context.Context) ([]entity.CategoryEntity, error) {
 	var modelCategories []model.Category
 
-	err = c.db.Order("created_at DESC").Preload("User").Find(&modelCategories).Error
+	err = c.withPostCount().Order("display_order ASC, created_at DESC").Preload("User").Find(&modelCategories).Error
 	if err != nil {
 		code = "[REPOSITORY] GetCategories - 1"
 		log.Errorw(code, err)
@@ -132,9 +148,17 @@ func (c *categoryRepository) GetCategories(ctx context.Context) ([]entity.Catego
 	var resps []entity.CategoryEntity
 	for _, val := range modelCategories {
 		resps = append(resps, entity.CategoryEntity{
-			ID:    val.ID,
-			Title: val.Title,
-			Slug:  val.Slug,
+			ID:                 val.ID,
+			Title:              val.Title,
+			Slug:               val.Slug,
+			Description:        val.Description,
+			Image:              val.Image,
+			MetaTitle:          val.MetaTitle,
+			MetaDescription:    val.MetaDescription,
+			DisplayOrder:       val.DisplayOrder,
+			Locale:             val.Locale,
+			TranslationGroupID: val.TranslationGroupID,
+			PostCount:          val.PostCount,
 			User: entity.UserEntity{
 				ID:       val.User.ID,
 				Name:     val.User.Name,
@@ -147,20 +171,84 @@ func (c *categoryRepository) GetCategories(ctx context.Context) ([]entity.Catego
 	return resps, nil
 }
 
+// GetCategoriesByLocale implements CategoryRepository. Each translation
+// group appears once, in locale when translated and in fallback otherwise.
+func (c *categoryRepository) GetCategoriesByLocale(ctx context.Context, locale, fallback string) ([]entity.CategoryEntity, error) {
+	var modelCategories []model.Category
+
+	err = c.withPostCount().
+		Where("locale = ? OR (locale = ? AND NOT EXISTS (SELECT 1 FROM categories AS t WHERE t.translation_group_id = categories.translation_group_id AND t.locale = ?))", locale, fallback, locale).
+		Order("display_order ASC, created_at DESC").
+		Preload("User").
+		Find(&modelCategories).Error
+	if err != nil {
+		code = "[REPOSITORY] GetCategoriesByLocale - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	if len(modelCategories) == 0 {
+		code = "[REPOSITORY] GetCategoriesByLocale - 2"
+		err = errors.New("data not found")
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	var resps []entity.CategoryEntity
+	for _, val := range modelCategories {
+		resps = append(resps, entity.CategoryEntity{
+			ID:                 val.ID,
+			Title:              val.Title,
+			Slug:               val.Slug,
+			Description:        val.Description,
+			Image:              val.Image,
+			MetaTitle:          val.MetaTitle,
+			MetaDescription:    val.MetaDescription,
+			DisplayOrder:       val.DisplayOrder,
+			Locale:             val.Locale,
+			TranslationGroupID: val.TranslationGroupID,
+			PostCount:          val.PostCount,
+			User: entity.UserEntity{
+				ID:   val.User.ID,
+				Name: val.User.Name,
+			},
+		})
+	}
+
+	return resps, nil
+}
+
 // GetCategoryByID implements CategoryRepository.
 func (c *categoryRepository) GetCategoryByID(ctx context.Context, id int64) (*entity.CategoryEntity, error) {
 	var modelCategory model.Category
-	err = c.db.Where("id = ?", id).Preload("User").First(&modelCategory).Error
+	err = c.withPostCount().Where("id = ?", id).Preload("User").First(&modelCategory).Error
 	if err != nil {
 		code = "[REPOSITORY] GetCategoryByID - 1"
 		log.Errorw(code, err)
 		return nil, err
 	}
 
+	translations, err := categoryTranslations(c.db, modelCategory.TranslationGroupID, modelCategory.ID)
+	if err != nil {
+		code = "[REPOSITORY] GetCategoryByID - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
 	return &entity.CategoryEntity{
-		ID:    modelCategory.ID,
-		Title: modelCategory.Title,
-		Slug:  modelCategory.Slug,
+		ID:                 modelCategory.ID,
+		Title:              modelCategory.Title,
+		Slug:               modelCategory.Slug,
+		Description:        modelCategory.Description,
+		Image:              modelCategory.Image,
+		MetaTitle:          modelCategory.MetaTitle,
+		MetaDescription:    modelCategory.MetaDescription,
+		DisplayOrder:       modelCategory.DisplayOrder,
+		Locale:             modelCategory.Locale,
+		TranslationGroupID: modelCategory.TranslationGroupID,
+		Translations:       translations,
+		Version:            modelCategory.Version,
+		PostCount:          modelCategory.PostCount,
 		User: entity.UserEntity{
 			ID:       modelCategory.User.ID,
 			Name:     modelCategory.User.Name,
@@ -170,6 +258,44 @@ func (c *categoryRepository) GetCategoryByID(ctx context.Context, id int64) (*en
 	}, nil
 }
 
+// GetCategoryBySlug implements CategoryRepository.
+func (c *categoryRepository) GetCategoryBySlug(ctx context.Context, slug string) (*entity.CategoryEntity, error) {
+	var modelCategory model.Category
+	err = c.withPostCount().Where("slug = ?", slug).Preload("User").First(&modelCategory).Error
+	if err != nil {
+		code = "[REPOSITORY] GetCategoryBySlug - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	translations, err := categoryTranslations(c.db, modelCategory.TranslationGroupID, modelCategory.ID)
+	if err != nil {
+		code = "[REPOSITORY] GetCategoryBySlug - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return &entity.CategoryEntity{
+		ID:                 modelCategory.ID,
+		Title:              modelCategory.Title,
+		Slug:               modelCategory.Slug,
+		Description:        modelCategory.Description,
+		Image:              modelCategory.Image,
+		MetaTitle:          modelCategory.MetaTitle,
+		MetaDescription:    modelCategory.MetaDescription,
+		DisplayOrder:       modelCategory.DisplayOrder,
+		Locale:             modelCategory.Locale,
+		TranslationGroupID: modelCategory.TranslationGroupID,
+		Translations:       translations,
+		Version:            modelCategory.Version,
+		PostCount:          modelCategory.PostCount,
+		User: entity.UserEntity{
+			ID:   modelCategory.User.ID,
+			Name: modelCategory.User.Name,
+		},
+	}, nil
+}
+
 func NewCategoryRepository(db *gorm.DB) CategoryRepository {
 	return &categoryRepository{
 		db: db,
@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+type CommentRepository interface {
+	GetComments(ctx context.Context, query entity.CommentQuery) ([]entity.CommentEntity, int64, int64, error)
+	GetApprovedComments(ctx context.Context, contentID int64) ([]entity.CommentEntity, error)
+	GetCommentByID(ctx context.Context, id int64) (*entity.CommentEntity, error)
+	GetCommentsByIDs(ctx context.Context, ids []int64) ([]entity.CommentEntity, error)
+	CountFingerprint(ctx context.Context, fingerprint string, since time.Time) (int64, int64, error)
+	CreateComment(ctx context.Context, req entity.CommentEntity) (int64, error)
+	EditCommentByID(ctx context.Context, req entity.CommentEntity) error
+	UpdateCommentStatus(ctx context.Context, ids []int64, status string) (int64, error)
+	DeleteComments(ctx context.Context, ids []int64) (int64, error)
+}
+
+type commentRepository struct {
+	db *gorm.DB
+}
+
+// CreateComment implements CommentRepository.
+func (c *commentRepository) CreateComment(ctx context.Context, req entity.CommentEntity) (int64, error) {
+	modelComment := model.Comment{
+		ContentID:   req.ContentID,
+		Name:        req.Name,
+		Email:       req.Email,
+		Body:        req.Body,
+		Status:      req.Status,
+		IPAddress:   req.IPAddress,
+		UserAgent:   req.UserAgent,
+		Fingerprint: req.Fingerprint,
+		SpamScore:   req.SpamScore,
+		SpamReasons: strings.Join(req.SpamReasons, "\n"),
+	}
+
+	if req.ParentID > 0 {
+		modelComment.ParentID = &req.ParentID
+	}
+
+	err = c.db.Create(&modelComment).Error
+	if err != nil {
+		code = "[REPOSITORY] CreateComment - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return modelComment.ID, nil
+}
+
+// DeleteComments implements CommentRepository.
+func (c *commentRepository) DeleteComments(ctx context.Context, ids []int64) (int64, error) {
+	result := c.db.Where("id IN ?", ids).Delete(&model.Comment{})
+	if result.Error != nil {
+		code = "[REPOSITORY] DeleteComments - 1"
+		log.Errorw(code, result.Error)
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// EditCommentByID implements CommentRepository.
+func (c *commentRepository) EditCommentByID(ctx context.Context, req entity.CommentEntity) error {
+	now := time.Now()
+	modelComment := model.Comment{
+		Name:      req.Name,
+		Body:      req.Body,
+		UpdatedAt: &now,
+	}
+
+	err = c.db.Where("id = ?", req.ID).Updates(&modelComment).Error
+	if err != nil {
+		code = "[REPOSITORY] EditCommentByID - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateCommentStatus implements CommentRepository.
+func (c *commentRepository) UpdateCommentStatus(ctx context.Context, ids []int64, status string) (int64, error) {
+	result := c.db.Model(&model.Comment{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{"status": status, "updated_at": time.Now()})
+	if result.Error != nil {
+		code = "[REPOSITORY] UpdateCommentStatus - 1"
+		log.Errorw(code, result.Error)
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// GetApprovedComments implements CommentRepository.
+func (c *commentRepository) GetApprovedComments(ctx context.Context, contentID int64) ([]entity.CommentEntity, error) {
+	var modelComments []model.Comment
+
+	err = c.db.Where("content_id = ? AND status = ?", contentID, entity.CommentStatusApproved).
+		Order("created_at ASC").
+		Find(&modelComments).Error
+	if err != nil {
+		code = "[REPOSITORY] GetApprovedComments - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resps := []entity.CommentEntity{}
+	for _, val := range modelComments {
+		var parentID int64
+		if val.ParentID != nil {
+			parentID = *val.ParentID
+		}
+
+		resp := entity.CommentEntity{
+			ID:        val.ID,
+			ContentID: val.ContentID,
+			ParentID:  parentID,
+			Name:      val.Name,
+			Body:      val.Body,
+			Status:    val.Status,
+			CreatedAt: val.CreatedAt,
+		}
+
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
+
+// GetCommentByID implements CommentRepository.
+func (c *commentRepository) GetCommentByID(ctx context.Context, id int64) (*entity.CommentEntity, error) {
+	var modelComment model.Comment
+
+	err = c.db.Where("id = ?", id).Preload("Content").First(&modelComment).Error
+	if err != nil {
+		code = "[REPOSITORY] GetCommentByID - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	var parentID int64
+	if modelComment.ParentID != nil {
+		parentID = *modelComment.ParentID
+	}
+
+	resp := entity.CommentEntity{
+		ID:          modelComment.ID,
+		ContentID:   modelComment.ContentID,
+		ParentID:    parentID,
+		Name:        modelComment.Name,
+		Email:       modelComment.Email,
+		Body:        modelComment.Body,
+		Status:      modelComment.Status,
+		IPAddress:   modelComment.IPAddress,
+		UserAgent:   modelComment.UserAgent,
+		Fingerprint: modelComment.Fingerprint,
+		SpamScore:   modelComment.SpamScore,
+		SpamReasons: splitReasons(modelComment.SpamReasons),
+		CreatedAt:   modelComment.CreatedAt,
+		Content: entity.ContentEntity{
+			ID:     modelComment.Content.ID,
+			Title:  modelComment.Content.Title,
+			Slug:   modelComment.Content.Slug,
+			Status: modelComment.Content.Status,
+		},
+	}
+
+	return &resp, nil
+}
+
+// GetComments implements CommentRepository.
+func (c *commentRepository) GetComments(ctx context.Context, query entity.CommentQuery) ([]entity.CommentEntity, int64, int64, error) {
+	var modelComments []model.Comment
+	var countData int64
+
+	offset := (query.Page - 1) * query.Limit
+
+	sqlMain := c.db.Model(&model.Comment{}).Preload("Content")
+	if query.Status != "" {
+		sqlMain = sqlMain.Where("status = ?", query.Status)
+	}
+
+	if query.ContentID > 0 {
+		sqlMain = sqlMain.Where("content_id = ?", query.ContentID)
+	}
+
+	if query.Search != "" {
+		sqlMain = sqlMain.Where("name ILIKE ? OR email ILIKE ? OR body ILIKE ?", "%"+query.Search+"%", "%"+query.Search+"%", "%"+query.Search+"%")
+	}
+
+	err = sqlMain.Count(&countData).Error
+	if err != nil {
+		code = "[REPOSITORY] GetComments - 1"
+		log.Errorw(code, err)
+		return nil, 0, 0, err
+	}
+
+	totalPages := int(math.Ceil(float64(countData) / float64(query.Limit)))
+
+	err = sqlMain.Offset(offset).
+		Limit(query.Limit).
+		Order("created_at DESC").
+		Find(&modelComments).Error
+	if err != nil {
+		code = "[REPOSITORY] GetComments - 2"
+		log.Errorw(code, err)
+		return nil, 0, 0, err
+	}
+
+	resps := []entity.CommentEntity{}
+	for _, val := range modelComments {
+		var parentID int64
+		if val.ParentID != nil {
+			parentID = *val.ParentID
+		}
+
+		resp := entity.CommentEntity{
+			ID:          val.ID,
+			ContentID:   val.ContentID,
+			ParentID:    parentID,
+			Name:        val.Name,
+			Email:       val.Email,
+			Body:        val.Body,
+			Status:      val.Status,
+			IPAddress:   val.IPAddress,
+			UserAgent:   val.UserAgent,
+			Fingerprint: val.Fingerprint,
+			SpamScore:   val.SpamScore,
+			SpamReasons: splitReasons(val.SpamReasons),
+			CreatedAt:   val.CreatedAt,
+			Content: entity.ContentEntity{
+				ID:     val.Content.ID,
+				Title:  val.Content.Title,
+				Slug:   val.Content.Slug,
+				Status: val.Content.Status,
+			},
+		}
+
+		resps = append(resps, resp)
+	}
+
+	return resps, countData, int64(totalPages), nil
+}
+
+// GetCommentsByIDs implements CommentRepository.
+func (c *commentRepository) GetCommentsByIDs(ctx context.Context, ids []int64) ([]entity.CommentEntity, error) {
+	var modelComments []model.Comment
+
+	err = c.db.Where("id IN ?", ids).Find(&modelComments).Error
+	if err != nil {
+		code = "[REPOSITORY] GetCommentsByIDs - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resps := []entity.CommentEntity{}
+	for _, val := range modelComments {
+		resp := entity.CommentEntity{
+			ID:          val.ID,
+			ContentID:   val.ContentID,
+			Name:        val.Name,
+			Email:       val.Email,
+			Body:        val.Body,
+			Status:      val.Status,
+			Fingerprint: val.Fingerprint,
+			CreatedAt:   val.CreatedAt,
+		}
+
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
+
+// CountFingerprint implements CommentRepository. It returns the number of
+// comments with the same fingerprint posted since the given time, and the
+// number of such comments ever marked as spam.
+func (c *commentRepository) CountFingerprint(ctx context.Context, fingerprint string, since time.Time) (int64, int64, error) {
+	var counts struct {
+		Recent  int64
+		Flagged int64
+	}
+
+	err = c.db.Model(&model.Comment{}).
+		Select("COUNT(*) FILTER (WHERE created_at >= ?) AS recent, COUNT(*) FILTER (WHERE status = ?) AS flagged", since, entity.CommentStatusSpam).
+		Where("fingerprint = ?", fingerprint).
+		Scan(&counts).Error
+	if err != nil {
+		code = "[REPOSITORY] CountFingerprint - 1"
+		log.Errorw(code, err)
+		return 0, 0, err
+	}
+
+	return counts.Recent, counts.Flagged, nil
+}
+
+func splitReasons(reasons string) []string {
+	if reasons == "" {
+		return nil
+	}
+
+	return strings.Split(reasons, "\n")
+}
+
+func NewCommentRepository(db *gorm.DB) CommentRepository {
+	return &commentRepository{
+		db: db,
+	}
+}
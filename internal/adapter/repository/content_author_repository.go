@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+
+	"gorm.io/gorm"
+)
+
+// contentAuthors loads the credits of contents in order, keyed by content.
+func contentAuthors(db *gorm.DB, contentIDs []int64) (map[int64][]entity.ContentAuthorEntity, error) {
+	authors := make(map[int64][]entity.ContentAuthorEntity, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return authors, nil
+	}
+
+	var modelAuthors []model.ContentAuthor
+	err := db.Where("content_id IN ?", contentIDs).
+		Preload("User").
+		Order("content_id ASC, position ASC").
+		Find(&modelAuthors).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, val := range modelAuthors {
+		authors[val.ContentID] = append(authors[val.ContentID], entity.ContentAuthorEntity{
+			Role:     val.Role,
+			Position: val.Position,
+			User: entity.UserEntity{
+				ID:          val.User.ID,
+				Name:        val.User.Name,
+				DisplayName: val.User.DisplayName,
+				Avatar:      val.User.Avatar,
+				Slug:        val.User.Slug,
+			},
+		})
+	}
+
+	return authors, nil
+}
+
+// setContentAuthors replaces the credits of a content with authors, in the
+// given order. The first credit with the author role becomes the content's
+// primary user.
+func setContentAuthors(tx *gorm.DB, contentID int64, authors []entity.ContentAuthorEntity) error {
+	if err := tx.Where("content_id = ?", contentID).Delete(&model.ContentAuthor{}).Error; err != nil {
+		return err
+	}
+
+	modelAuthors := []model.ContentAuthor{}
+	var primaryID int64
+	for i, val := range authors {
+		modelAuthors = append(modelAuthors, model.ContentAuthor{
+			ContentID: contentID,
+			UserID:    val.User.ID,
+			Role:      val.Role,
+			Position:  i + 1,
+		})
+
+		if primaryID == 0 && val.Role == entity.AuthorRoleAuthor {
+			primaryID = val.User.ID
+		}
+	}
+	if len(modelAuthors) == 0 {
+		return nil
+	}
+
+	if err := tx.Omit("User").Create(&modelAuthors).Error; err != nil {
+		return err
+	}
+
+	// without an author credit the primary user is left as it was
+	if primaryID == 0 {
+		return nil
+	}
+
+	return tx.Model(&model.Content{}).Where("id = ?", contentID).Update("user_id", primaryID).Error
+}
+
+// authoredBy matches contents a user is credited on, in any role.
+const authoredBy = "EXISTS (SELECT 1 FROM content_authors WHERE content_authors.content_id = contents.id AND content_authors.user_id = ?)"
@@ -4,9 +4,12 @@ import (
 	"blog/internal/core/domain/entity"
 	"blog/internal/core/domain/model"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2/log"
 	"gorm.io/gorm"
@@ -16,37 +19,166 @@ import (
 type ContentRepository interface {
 	GetContents(ctx context.Context, query entity.QueryString) ([]entity.ContentEntity, int64, int64, error)
 	GetContentByID(ctx context.Context, id int64) (*entity.ContentEntity, error)
-	CreateContent(ctx context.Context, req entity.ContentEntity) error
+	GetContentBySlug(ctx context.Context, slug string) (*entity.ContentEntity, error)
+	GetRelatedContents(ctx context.Context, id int64, limit int) ([]entity.RelatedContentEntity, error)
+	CreateContent(ctx context.Context, req entity.ContentEntity) (int64, error)
 	EditContentByID(ctx context.Context, req entity.ContentEntity) error
 	DeleteContent(ctx context.Context, id int64) error
+	SetFeatured(ctx context.Context, req entity.FeatureEntity) error
+	ReorderFeatured(ctx context.Context, contentIDs []int64) error
+	GetFeaturedIDs(ctx context.Context, contentIDs []int64) ([]int64, error)
+	SetPinned(ctx context.Context, id int64, pinned bool) error
+	GetDuplicateTitles(ctx context.Context, id int64, title string) ([]entity.ContentEntity, error)
+	SaveDraft(ctx context.Context, req entity.ContentDraftEntity) error
+	DeleteDraft(ctx context.Context, id int64) error
+	PublishDraft(ctx context.Context, req entity.ContentEntity, savedAt time.Time) error
+	BulkContents(ctx context.Context, req entity.BulkContentEntity) ([]entity.BulkResultEntity, error)
+	SetContentAuthors(ctx context.Context, contentID int64, authors []entity.ContentAuthorEntity) error
 }
 
 type contentRepository struct {
 	db *gorm.DB
 }
 
+// contentOrderColumns maps the orderBy values accepted from clients to columns.
+var contentOrderColumns = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"title":      "title",
+	"status":     "status",
+	"reactions":  "reaction_count",
+	"views":      "view_count",
+	"comments":   "comment_count",
+}
+
+// contentOrder builds a safe ORDER BY clause, falling back to newest first.
+func contentOrder(orderBy, orderType string) string {
+	column, ok := contentOrderColumns[strings.ToLower(orderBy)]
+	if !ok {
+		column = "created_at"
+	}
+
+	direction := "DESC"
+	if strings.EqualFold(orderType, "asc") {
+		direction = "ASC"
+	}
+
+	if column == "created_at" {
+		return fmt.Sprintf("%s %s", column, direction)
+	}
+
+	return fmt.Sprintf("%s %s, created_at DESC", column, direction)
+}
+
+// deletedAt returns when a content was deleted, or nil when it is live.
+func deletedAt(val model.Content) *time.Time {
+	if !val.DeletedAt.Valid {
+		return nil
+	}
+
+	return &val.DeletedAt.Time
+}
+
+// activeFeatured matches contents holding a featured slot that has not expired.
+const activeFeatured = "featured_position IS NOT NULL AND (featured_until IS NULL OR featured_until > now())"
+
+// featuredPosition returns the featured slot of a content, or 0 when it is
+// not featured or its feature has expired.
+func featuredPosition(val model.Content) int {
+	if val.FeaturedPosition == nil {
+		return 0
+	}
+	if val.FeaturedUntil != nil && !val.FeaturedUntil.After(time.Now()) {
+		return 0
+	}
+
+	return *val.FeaturedPosition
+}
+
+// encodeToc serializes a table of contents for the toc column.
+func encodeToc(toc []entity.HeadingEntity) string {
+	if len(toc) == 0 {
+		return ""
+	}
+
+	data, err := json.Marshal(toc)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// decodeToc reads the toc column; contents saved before it existed have none.
+func decodeToc(toc string) []entity.HeadingEntity {
+	headings := []entity.HeadingEntity{}
+	if toc == "" {
+		return headings
+	}
+
+	if err := json.Unmarshal([]byte(toc), &headings); err != nil {
+		return []entity.HeadingEntity{}
+	}
+
+	return headings
+}
+
+// withCommentCount selects contents together with their number of approved comments.
+func withCommentCount(db *gorm.DB) *gorm.DB {
+	return db.Select("contents.*, (SELECT COUNT(*) FROM comments WHERE comments.content_id = contents.id AND comments.status = ?) AS comment_count", entity.CommentStatusApproved)
+}
+
 // CreateContent implements ContentRepository.
-func (c *contentRepository) CreateContent(ctx context.Context, req entity.ContentEntity) error {
+func (c *contentRepository) CreateContent(ctx context.Context, req entity.ContentEntity) (int64, error) {
 	tags := strings.Join(req.Tags, ",")
 	modelContent := model.Content{
-		Title:       req.Title,
-		Excerpt:     req.Excerpt,
-		Description: req.Description,
-		Image:       req.Image,
-		Tags:        tags,
-		Status:      req.Status,
-		CategoryID:  req.CategoryID,
-		UserID:      req.UserID,
+		Title:              req.Title,
+		Excerpt:            req.Excerpt,
+		Description:        req.Description,
+		Image:              req.Image,
+		Tags:               tags,
+		Status:             req.Status,
+		Locale:             req.Locale,
+		TranslationGroupID: req.TranslationGroupID,
+		WordCount:          req.WordCount,
+		ReadingTime:        req.ReadingTime,
+		Toc:                encodeToc(req.Toc),
+		MetaTitle:          req.MetaTitle,
+		MetaDescription:    req.MetaDescription,
+		CanonicalUrl:       req.CanonicalUrl,
+		OgImage:            req.OgImage,
+		NoIndex:            req.NoIndex,
+		JsonLD:             req.JsonLD,
+		CategoryID:         req.CategoryID,
+		UserID:             req.UserID,
 	}
 
-	err = c.db.Create(&modelContent).Error
+	// A new content starts its own translation group unless it translates
+	// another one, and is credited to its creator.
+	err = writeWithSlug(c.db, "contents", req.Slug, 0, func(slug string) error {
+		modelContent.ID = 0
+		modelContent.Slug = slug
+		return c.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(&modelContent).Error; err != nil {
+				return err
+			}
+			if modelContent.TranslationGroupID == 0 {
+				if err := tx.Model(&modelContent).Update("translation_group_id", modelContent.ID).Error; err != nil {
+					return err
+				}
+			}
+			return setContentAuthors(tx, modelContent.ID, []entity.ContentAuthorEntity{
+				{User: entity.UserEntity{ID: modelContent.UserID}, Role: entity.AuthorRoleAuthor},
+			})
+		})
+	})
 	if err != nil {
 		code = "[REPOSITORY] CreateContent - 1"
 		log.Errorw(code, err)
-		return err
+		return 0, err
 	}
 
-	return nil
+	return modelContent.ID, nil
 }
 
 // DeleteContent implements ContentRepository.
@@ -63,23 +195,58 @@ func (c *contentRepository) DeleteContent(ctx context.Context, id int64) error {
 
 // EditContentByID implements ContentRepository.
 func (c *contentRepository) EditContentByID(ctx context.Context, req entity.ContentEntity) error {
+	err = writeWithSlug(c.db, "contents", req.Slug, req.ID, func(slug string) error {
+		req.Slug = slug
+		return editContent(c.db, req)
+	})
+	if err != nil && !errors.Is(err, entity.ErrVersionConflict) {
+		code = "[REPOSITORY] EditContentByID - 1"
+		log.Errorw(code, err)
+	}
+
+	return err
+}
+
+// editContent saves req over the content it was loaded from, failing with
+// entity.ErrVersionConflict when that version has since been replaced.
+// req.Slug must already be free, see writeWithSlug.
+func editContent(db *gorm.DB, req entity.ContentEntity) error {
 	tags := strings.Join(req.Tags, ",")
 	modelContent := model.Content{
-		Title:       req.Title,
-		Excerpt:     req.Excerpt,
-		Description: req.Description,
-		Image:       req.Image,
-		Tags:        tags,
-		Status:      req.Status,
-		CategoryID:  req.CategoryID,
-		UserID:      req.UserID,
+		Title:              req.Title,
+		Slug:               req.Slug,
+		Excerpt:            req.Excerpt,
+		Description:        req.Description,
+		Image:              req.Image,
+		Tags:               tags,
+		Status:             req.Status,
+		Locale:             req.Locale,
+		TranslationGroupID: req.TranslationGroupID,
+		WordCount:          req.WordCount,
+		ReadingTime:        req.ReadingTime,
+		Toc:                encodeToc(req.Toc),
+		MetaTitle:          req.MetaTitle,
+		MetaDescription:    req.MetaDescription,
+		CanonicalUrl:       req.CanonicalUrl,
+		OgImage:            req.OgImage,
+		NoIndex:            req.NoIndex,
+		JsonLD:             req.JsonLD,
+		Version:            req.Version + 1,
+		CategoryID:         req.CategoryID,
 	}
 
-	err = c.db.Where("id = ?", req.ID).Updates(&modelContent).Error
-	if err != nil {
-		code = "[REPOSITORY] EditContentByID - 1"
-		log.Errorw(code, err)
-		return err
+	// the version check makes a concurrent save of the same version fail
+	// instead of silently overwriting this one
+	result := db.Where("id = ? AND version = ?", req.ID, req.Version).
+		Select("title", "slug", "excerpt", "description", "image", "tags", "status", "locale", "translation_group_id", "category_id",
+			"word_count", "reading_time", "toc", "meta_title", "meta_description", "canonical_url", "og_image", "no_index", "json_ld", "version").
+		Updates(&modelContent)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return entity.ErrVersionConflict
 	}
 
 	return nil
@@ -89,25 +256,94 @@ func (c *contentRepository) EditContentByID(ctx context.Context, req entity.Cont
 func (c *contentRepository) GetContentByID(ctx context.Context, id int64) (*entity.ContentEntity, error) {
 	var modelContent model.Content
 
-	err = c.db.Where("id = ?", id).Preload("Category").Preload("User").First(&modelContent).Error
+	err = withCommentCount(c.db).Where("id = ?", id).Preload("Category").Preload("User").First(&modelContent).Error
 	if err != nil {
 		code = "[REPOSITORY] GetContentByID - 1"
 		log.Errorw(code, err)
 		return nil, err
 	}
 
+	updatedAt := modelContent.CreatedAt
+	if modelContent.UpdatedAt != nil {
+		updatedAt = *modelContent.UpdatedAt
+	}
+
+	reactions, err := reactionCounts(c.db, []int64{modelContent.ID})
+	if err != nil {
+		code = "[REPOSITORY] GetContentByID - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	series, err := seriesNav(c.db, modelContent.ID)
+	if err != nil {
+		code = "[REPOSITORY] GetContentByID - 3"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	translations, err := contentTranslations(c.db, modelContent.TranslationGroupID, modelContent.ID)
+	if err != nil {
+		code = "[REPOSITORY] GetContentByID - 4"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	lock, err := contentLock(c.db, modelContent.ID)
+	if err != nil {
+		code = "[REPOSITORY] GetContentByID - 5"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	draft, err := contentDraft(c.db, modelContent.ID)
+	if err != nil {
+		code = "[REPOSITORY] GetContentByID - 6"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	authors, err := contentAuthors(c.db, []int64{modelContent.ID})
+	if err != nil {
+		code = "[REPOSITORY] GetContentByID - 7"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
 	tags := strings.Split(modelContent.Tags, ",")
 	reps := entity.ContentEntity{
-		ID:          modelContent.ID,
-		Title:       modelContent.Title,
-		Excerpt:     modelContent.Excerpt,
-		Description: modelContent.Description,
-		Image:       modelContent.Image,
-		Tags:        tags,
-		Status:      modelContent.Status,
-		CategoryID:  modelContent.CategoryID,
-		UserID:      modelContent.UserID,
-		CreatedAt:   modelContent.CreatedAt,
+		ID:                 modelContent.ID,
+		Title:              modelContent.Title,
+		Slug:               modelContent.Slug,
+		Excerpt:            modelContent.Excerpt,
+		Description:        modelContent.Description,
+		Image:              modelContent.Image,
+		Tags:               tags,
+		Status:             modelContent.Status,
+		Locale:             modelContent.Locale,
+		TranslationGroupID: modelContent.TranslationGroupID,
+		Translations:       translations,
+		WordCount:          modelContent.WordCount,
+		ReadingTime:        modelContent.ReadingTime,
+		Toc:                decodeToc(modelContent.Toc),
+		MetaTitle:          modelContent.MetaTitle,
+		MetaDescription:    modelContent.MetaDescription,
+		CanonicalUrl:       modelContent.CanonicalUrl,
+		OgImage:            modelContent.OgImage,
+		NoIndex:            modelContent.NoIndex,
+		JsonLD:             modelContent.JsonLD,
+		Version:            modelContent.Version,
+		CategoryID:         modelContent.CategoryID,
+		UserID:             modelContent.UserID,
+		CreatedAt:          modelContent.CreatedAt,
+		UpdatedAt:          updatedAt,
+		FeaturedPosition:   featuredPosition(modelContent),
+		FeaturedUntil:      modelContent.FeaturedUntil,
+		Pinned:             modelContent.Pinned,
+		CommentCount:       modelContent.CommentCount,
+		ReactionCount:      modelContent.ReactionCount,
+		ViewCount:          modelContent.ViewCount,
+		Reactions:          reactions[modelContent.ID],
 		Category: entity.CategoryEntity{
 			ID:    modelContent.Category.ID,
 			Title: modelContent.Category.Title,
@@ -117,17 +353,35 @@ func (c *contentRepository) GetContentByID(ctx context.Context, id int64) (*enti
 			ID:   modelContent.User.ID,
 			Name: modelContent.User.Name,
 		},
+		Authors: authors[modelContent.ID],
+		Series:  series,
+		Lock:    lock,
+		Draft:   draft,
 	}
 
 	return &reps, nil
 }
 
+// GetContentBySlug implements ContentRepository.
+func (c *contentRepository) GetContentBySlug(ctx context.Context, slug string) (*entity.ContentEntity, error) {
+	var modelContent model.Content
+
+	err = c.db.Select("id").Where("slug = ?", slug).First(&modelContent).Error
+	if err != nil {
+		code = "[REPOSITORY] GetContentBySlug - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return c.GetContentByID(ctx, modelContent.ID)
+}
+
 // GetContents implements ContentRepository.
 func (c *contentRepository) GetContents(ctx context.Context, query entity.QueryString) ([]entity.ContentEntity, int64, int64, error) {
 	var modelContents []model.Content
 	var countData int64
 
-	order := fmt.Sprintf("%s %s", query.OrderBy, query.OrderType)
+	order := contentOrder(query.OrderBy, query.OrderType)
 	offset := (query.Page - 1) * query.Limit
 	status := ""
 	if query.Status != "" {
@@ -138,10 +392,38 @@ func (c *contentRepository) GetContents(ctx context.Context, query entity.QueryS
 		Where("title LIKE ? OR excerpt LIKE ? OR description LIKE ?", "%"+query.Search+"%", "%"+query.Search+"%", "%"+query.Search+"%").
 		Where("status LIKE ?", "%"+status+"%")
 
+	if query.Deleted {
+		sqlMain = sqlMain.Unscoped().Where("deleted_at IS NOT NULL")
+	}
+
 	if query.CategoryID > 0 {
 		sqlMain = sqlMain.Where("category_id = ?", query.CategoryID)
 	}
 
+	if query.AuthorID > 0 {
+		sqlMain = sqlMain.Where(authoredBy, query.AuthorID)
+	}
+
+	if query.Tag != "" {
+		sqlMain = sqlMain.Where("EXISTS (SELECT 1 FROM unnest(string_to_array(tags, ',')) AS tag WHERE lower(trim(tag)) = lower(?))", query.Tag)
+	}
+
+	if query.Locale != "" {
+		if query.FallbackLocale != "" && query.FallbackLocale != query.Locale {
+			sqlMain = sqlMain.Where("locale = ? OR (locale = ? AND NOT EXISTS (SELECT 1 FROM contents AS t WHERE t.translation_group_id = contents.translation_group_id AND t.locale = ? AND t.status LIKE ? AND t.deleted_at IS NULL))",
+				query.Locale, query.FallbackLocale, query.Locale, "%"+status+"%")
+		} else {
+			sqlMain = sqlMain.Where("locale = ?", query.Locale)
+		}
+	}
+
+	if query.Featured {
+		sqlMain = sqlMain.Where(activeFeatured)
+		order = "featured_position ASC, " + order
+	} else if query.CategoryID > 0 {
+		order = "pinned DESC, " + order
+	}
+
 	err = sqlMain.Model(&modelContents).Count(&countData).Error
 	if err != nil {
 		code = "[REPOSITORY] GetContents - 1"
@@ -151,7 +433,7 @@ func (c *contentRepository) GetContents(ctx context.Context, query entity.QueryS
 
 	totalPages := int(math.Ceil(float64(countData) / float64(query.Limit)))
 
-	err = sqlMain.Offset(offset).
+	err = withCommentCount(sqlMain).Offset(offset).
 		Limit(query.Limit).
 		Order(order).
 		Find(&modelContents).Error
@@ -161,20 +443,57 @@ func (c *contentRepository) GetContents(ctx context.Context, query entity.QueryS
 		return nil, 0, 0, err
 	}
 
+	contentIDs := []int64{}
+	for _, val := range modelContents {
+		contentIDs = append(contentIDs, val.ID)
+	}
+
+	reactions, err := reactionCounts(c.db, contentIDs)
+	if err != nil {
+		code = "[REPOSITORY] GetContents - 3"
+		log.Errorw(code, err)
+		return nil, 0, 0, err
+	}
+
+	authors, err := contentAuthors(c.db, contentIDs)
+	if err != nil {
+		code = "[REPOSITORY] GetContents - 4"
+		log.Errorw(code, err)
+		return nil, 0, 0, err
+	}
+
 	var resps []entity.ContentEntity
 	for _, val := range modelContents {
+		updatedAt := val.CreatedAt
+		if val.UpdatedAt != nil {
+			updatedAt = *val.UpdatedAt
+		}
+
 		tags := strings.Split(val.Tags, ",")
 		resp := entity.ContentEntity{
-			ID:          val.ID,
-			Title:       val.Title,
-			Excerpt:     val.Excerpt,
-			Description: val.Description,
-			Image:       val.Image,
-			Tags:        tags,
-			Status:      val.Status,
-			CategoryID:  val.CategoryID,
-			UserID:      val.UserID,
-			CreatedAt:   val.CreatedAt,
+			ID:               val.ID,
+			Title:            val.Title,
+			Slug:             val.Slug,
+			Excerpt:          val.Excerpt,
+			Description:      val.Description,
+			Image:            val.Image,
+			Tags:             tags,
+			Status:           val.Status,
+			Locale:           val.Locale,
+			WordCount:        val.WordCount,
+			ReadingTime:      val.ReadingTime,
+			CategoryID:       val.CategoryID,
+			UserID:           val.UserID,
+			CreatedAt:        val.CreatedAt,
+			UpdatedAt:        updatedAt,
+			FeaturedPosition: featuredPosition(val),
+			FeaturedUntil:    val.FeaturedUntil,
+			Pinned:           val.Pinned,
+			DeletedAt:        deletedAt(val),
+			CommentCount:     val.CommentCount,
+			ReactionCount:    val.ReactionCount,
+			ViewCount:        val.ViewCount,
+			Reactions:        reactions[val.ID],
 			Category: entity.CategoryEntity{
 				ID:    val.Category.ID,
 				Title: val.Category.Title,
@@ -184,6 +503,7 @@ func (c *contentRepository) GetContents(ctx context.Context, query entity.QueryS
 				ID:   val.User.ID,
 				Name: val.User.Name,
 			},
+			Authors: authors[val.ID],
 		}
 
 		resps = append(resps, resp)
@@ -192,6 +512,565 @@ func (c *contentRepository) GetContents(ctx context.Context, query entity.QueryS
 	return resps, countData, int64(totalPages), nil
 }
 
+// relatedHalfLifeDays is the age at which a related post loses half of the
+// recency part of its score.
+const relatedHalfLifeDays = 180
+
+// GetRelatedContents implements ContentRepository. Other published contents
+// are scored by shared tags, same category and trigram similarity of title
+// and excerpt, then weighted by a recency decay.
+func (c *contentRepository) GetRelatedContents(ctx context.Context, id int64, limit int) ([]entity.RelatedContentEntity, error) {
+	var ranked []struct {
+		ID    int64
+		Score float64
+	}
+
+	err = c.db.Raw(`
+		WITH src AS (
+			SELECT id, category_id, locale, title || ' ' || excerpt AS body,
+				ARRAY(SELECT lower(trim(tag)) FROM unnest(string_to_array(tags, ',')) AS tag WHERE trim(tag) <> '') AS tags
+			FROM contents WHERE id = ?
+		), scored AS (
+			SELECT contents.id, contents.created_at,
+				2.0 * (SELECT COUNT(*) FROM unnest(string_to_array(contents.tags, ',')) AS tag WHERE lower(trim(tag)) = ANY(src.tags))
+				+ CASE WHEN contents.category_id = src.category_id THEN 1.5 ELSE 0 END
+				+ 3.0 * similarity(contents.title || ' ' || contents.excerpt, src.body) AS relevance
+			FROM contents, src
+			WHERE contents.id <> src.id AND contents.locale = src.locale AND contents.status = ? AND contents.deleted_at IS NULL
+		)
+		SELECT id, relevance * (0.5 + 0.5 * power(0.5, EXTRACT(EPOCH FROM (now() - created_at)) / 86400.0 / ?)) AS score
+		FROM scored
+		WHERE relevance > 0
+		ORDER BY score DESC, created_at DESC
+		LIMIT ?`, id, "PUBLISH", relatedHalfLifeDays, limit).
+		Scan(&ranked).Error
+	if err != nil {
+		code = "[REPOSITORY] GetRelatedContents - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	contentIDs := []int64{}
+	for _, val := range ranked {
+		contentIDs = append(contentIDs, val.ID)
+	}
+
+	var modelContents []model.Content
+	err = c.db.Where("id IN ?", contentIDs).Preload("Category").Preload("User").Find(&modelContents).Error
+	if err != nil {
+		code = "[REPOSITORY] GetRelatedContents - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	authors, err := contentAuthors(c.db, contentIDs)
+	if err != nil {
+		code = "[REPOSITORY] GetRelatedContents - 3"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	contents := map[int64]model.Content{}
+	for _, val := range modelContents {
+		contents[val.ID] = val
+	}
+
+	resps := []entity.RelatedContentEntity{}
+	for _, rank := range ranked {
+		val, ok := contents[rank.ID]
+		if !ok {
+			continue
+		}
+
+		updatedAt := val.CreatedAt
+		if val.UpdatedAt != nil {
+			updatedAt = *val.UpdatedAt
+		}
+
+		resp := entity.RelatedContentEntity{
+			Content: entity.ContentEntity{
+				ID:          val.ID,
+				Title:       val.Title,
+				Slug:        val.Slug,
+				Excerpt:     val.Excerpt,
+				Image:       val.Image,
+				Tags:        strings.Split(val.Tags, ","),
+				Status:      val.Status,
+				WordCount:   val.WordCount,
+				ReadingTime: val.ReadingTime,
+				CategoryID:  val.CategoryID,
+				UserID:      val.UserID,
+				CreatedAt:   val.CreatedAt,
+				UpdatedAt:   updatedAt,
+				Category: entity.CategoryEntity{
+					ID:    val.Category.ID,
+					Title: val.Category.Title,
+					Slug:  val.Category.Slug,
+				},
+				User: entity.UserEntity{
+					ID:   val.User.ID,
+					Name: val.User.Name,
+				},
+				Authors: authors[val.ID],
+			},
+			Score: rank.Score,
+		}
+
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
+
+// SetFeatured implements ContentRepository. Featuring a content at a taken
+// position moves the following slots down by one; a position of 0 appends
+// it after the last slot.
+func (c *contentRepository) SetFeatured(ctx context.Context, req entity.FeatureEntity) error {
+	err = c.db.Transaction(func(tx *gorm.DB) error {
+		if !req.Featured {
+			return tx.Model(&model.Content{}).Where("id = ?", req.ContentID).
+				Updates(map[string]interface{}{"featured_position": nil, "featured_until": nil}).Error
+		}
+
+		position := req.Position
+		if position <= 0 {
+			err := tx.Model(&model.Content{}).
+				Where("id <> ? AND featured_position IS NOT NULL", req.ContentID).
+				Select("COALESCE(MAX(featured_position), 0) + 1").
+				Scan(&position).Error
+			if err != nil {
+				return err
+			}
+		} else {
+			err := tx.Model(&model.Content{}).
+				Where("id <> ? AND featured_position >= ?", req.ContentID, position).
+				UpdateColumn("featured_position", gorm.Expr("featured_position + 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.Content{}).Where("id = ?", req.ContentID).
+			Updates(map[string]interface{}{"featured_position": position, "featured_until": req.Until}).Error
+	})
+	if err != nil {
+		code = "[REPOSITORY] SetFeatured - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// ReorderFeatured implements ContentRepository. The given contents take
+// slots 1..n in that order and must all be featured already; featured
+// contents left out keep their relative order after them.
+func (c *contentRepository) ReorderFeatured(ctx context.Context, contentIDs []int64) error {
+	err = c.db.Transaction(func(tx *gorm.DB) error {
+		var rest []int64
+		err := tx.Model(&model.Content{}).
+			Where("featured_position IS NOT NULL AND id NOT IN ?", append([]int64{0}, contentIDs...)).
+			Order("featured_position ASC, id ASC").
+			Pluck("id", &rest).Error
+		if err != nil {
+			return err
+		}
+
+		for i, contentID := range contentIDs {
+			result := tx.Model(&model.Content{}).
+				Where("id = ? AND featured_position IS NOT NULL", contentID).
+				UpdateColumn("featured_position", i+1)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("content %d is not featured", contentID)
+			}
+		}
+
+		for i, contentID := range rest {
+			err = tx.Model(&model.Content{}).
+				Where("id = ?", contentID).
+				UpdateColumn("featured_position", len(contentIDs)+i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		code = "[REPOSITORY] ReorderFeatured - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetFeaturedIDs implements ContentRepository. It returns which of
+// contentIDs are currently featured.
+func (c *contentRepository) GetFeaturedIDs(ctx context.Context, contentIDs []int64) ([]int64, error) {
+	var featured []int64
+	err = c.db.Model(&model.Content{}).
+		Where("id IN ? AND featured_position IS NOT NULL", contentIDs).
+		Pluck("id", &featured).Error
+	if err != nil {
+		code = "[REPOSITORY] GetFeaturedIDs - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return featured, nil
+}
+
+// SetPinned implements ContentRepository.
+func (c *contentRepository) SetPinned(ctx context.Context, id int64, pinned bool) error {
+	err = c.db.Model(&model.Content{}).Where("id = ?", id).UpdateColumn("pinned", pinned).Error
+	if err != nil {
+		code = "[REPOSITORY] SetPinned - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetDuplicateTitles implements ContentRepository. It returns the other
+// contents whose effective title, the meta title when set, equals title.
+func (c *contentRepository) GetDuplicateTitles(ctx context.Context, id int64, title string) ([]entity.ContentEntity, error) {
+	var modelContents []model.Content
+
+	err = c.db.Select("id, title, slug, status, meta_title").
+		Where("id <> ? AND lower(btrim(COALESCE(NULLIF(meta_title, ''), title))) = lower(btrim(?))", id, title).
+		Order("id ASC").
+		Find(&modelContents).Error
+	if err != nil {
+		code = "[REPOSITORY] GetDuplicateTitles - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resps := []entity.ContentEntity{}
+	for _, val := range modelContents {
+		resps = append(resps, entity.ContentEntity{
+			ID:        val.ID,
+			Title:     val.Title,
+			Slug:      val.Slug,
+			Status:    val.Status,
+			MetaTitle: val.MetaTitle,
+		})
+	}
+
+	return resps, nil
+}
+
+// contentDraft returns the working copy of a content, or nil when it has
+// none.
+func contentDraft(db *gorm.DB, id int64) (*entity.ContentDraftEntity, error) {
+	var modelDraft model.ContentDraft
+	err := db.Where("content_id = ?", id).Preload("User").First(&modelDraft).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var categoryID int64
+	if modelDraft.CategoryID != nil {
+		categoryID = *modelDraft.CategoryID
+	}
+
+	tags := []string{}
+	if modelDraft.Tags != "" {
+		tags = strings.Split(modelDraft.Tags, ",")
+	}
+
+	return &entity.ContentDraftEntity{
+		ContentID:       modelDraft.ContentID,
+		BaseVersion:     modelDraft.BaseVersion,
+		Title:           modelDraft.Title,
+		Excerpt:         modelDraft.Excerpt,
+		Description:     modelDraft.Description,
+		Image:           modelDraft.Image,
+		Tags:            tags,
+		CategoryID:      categoryID,
+		MetaTitle:       modelDraft.MetaTitle,
+		MetaDescription: modelDraft.MetaDescription,
+		CanonicalUrl:    modelDraft.CanonicalUrl,
+		OgImage:         modelDraft.OgImage,
+		NoIndex:         modelDraft.NoIndex,
+		JsonLD:          modelDraft.JsonLD,
+		SavedAt:         modelDraft.SavedAt,
+		User: entity.UserEntity{
+			ID:   modelDraft.User.ID,
+			Name: modelDraft.User.Name,
+		},
+	}, nil
+}
+
+// SaveDraft implements ContentRepository. It replaces the working copy but
+// keeps the version it was first started from.
+func (c *contentRepository) SaveDraft(ctx context.Context, req entity.ContentDraftEntity) error {
+	modelDraft := model.ContentDraft{
+		ContentID:       req.ContentID,
+		UserID:          req.User.ID,
+		BaseVersion:     req.BaseVersion,
+		Title:           req.Title,
+		Excerpt:         req.Excerpt,
+		Description:     req.Description,
+		Image:           req.Image,
+		Tags:            strings.Join(req.Tags, ","),
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		CanonicalUrl:    req.CanonicalUrl,
+		OgImage:         req.OgImage,
+		NoIndex:         req.NoIndex,
+		JsonLD:          req.JsonLD,
+		SavedAt:         req.SavedAt,
+	}
+	if req.CategoryID > 0 {
+		modelDraft.CategoryID = &req.CategoryID
+	}
+
+	err = c.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "content_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "category_id", "title", "excerpt", "description", "image", "tags",
+			"meta_title", "meta_description", "canonical_url", "og_image", "no_index", "json_ld", "saved_at"}),
+	}).Create(&modelDraft).Error
+	if err != nil {
+		code = "[REPOSITORY] SaveDraft - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteDraft implements ContentRepository.
+func (c *contentRepository) DeleteDraft(ctx context.Context, id int64) error {
+	err = c.db.Where("content_id = ?", id).Delete(&model.ContentDraft{}).Error
+	if err != nil {
+		code = "[REPOSITORY] DeleteDraft - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// PublishDraft implements ContentRepository. The content is saved and its
+// draft dropped in one transaction. An autosave landing after savedAt, or a
+// save of the content since req.Version, rolls it back with
+// entity.ErrVersionConflict.
+func (c *contentRepository) PublishDraft(ctx context.Context, req entity.ContentEntity, savedAt time.Time) error {
+	err = writeWithSlug(c.db, "contents", req.Slug, req.ID, func(slug string) error {
+		req.Slug = slug
+		return c.db.Transaction(func(tx *gorm.DB) error {
+			if err := editContent(tx, req); err != nil {
+				return err
+			}
+
+			result := tx.Where("content_id = ? AND saved_at = ?", req.ID, savedAt).Delete(&model.ContentDraft{})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return entity.ErrVersionConflict
+			}
+
+			return nil
+		})
+	})
+	if err != nil && !errors.Is(err, entity.ErrVersionConflict) {
+		code = "[REPOSITORY] PublishDraft - 1"
+		log.Errorw(code, err)
+	}
+
+	return err
+}
+
+// errBulkRollback undoes a bulk batch that is a dry run or has failures.
+var errBulkRollback = errors.New("bulk rollback")
+
+// BulkContents implements ContentRepository. Every item runs in one
+// transaction, which is only committed when all of them succeed and it is
+// not a dry run. Items that can't take the action are reported rather than
+// failing the batch, so the caller sees every problem at once.
+func (c *contentRepository) BulkContents(ctx context.Context, req entity.BulkContentEntity) ([]entity.BulkResultEntity, error) {
+	resps := []entity.BulkResultEntity{}
+
+	err = c.db.Transaction(func(tx *gorm.DB) error {
+		categoryFound := true
+		if req.Action == entity.BulkActionCategory {
+			var count int64
+			if err := tx.Model(&model.Category{}).Where("id = ?", req.CategoryID).Count(&count).Error; err != nil {
+				return err
+			}
+			categoryFound = count > 0
+		}
+
+		failed := false
+		for _, id := range req.IDs {
+			var modelContent model.Content
+			err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&modelContent).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+
+			message := ""
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				message = "content not found"
+			case !categoryFound:
+				message = "category not found"
+			default:
+				message, err = bulkApply(tx, req, modelContent)
+				if err != nil {
+					return err
+				}
+			}
+
+			if message != "" {
+				failed = true
+			}
+			resps = append(resps, entity.BulkResultEntity{ID: id, Error: message})
+		}
+
+		if failed || req.DryRun {
+			return errBulkRollback
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errBulkRollback) {
+		code = "[REPOSITORY] BulkContents - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return resps, nil
+}
+
+// bulkApply runs a bulk action on one content. It returns why the content
+// can't take the action, or "" once it is applied.
+func bulkApply(tx *gorm.DB, req entity.BulkContentEntity, modelContent model.Content) (string, error) {
+	deleted := modelContent.DeletedAt.Valid
+
+	switch req.Action {
+	case entity.BulkActionDelete:
+		if deleted {
+			return "content is already deleted", nil
+		}
+		return "", tx.Where("id = ?", modelContent.ID).Delete(&model.Content{}).Error
+
+	case entity.BulkActionRestore:
+		if !deleted {
+			return "content is not deleted", nil
+		}
+
+		var count int64
+		err := tx.Model(&model.Content{}).
+			Where("translation_group_id = ? AND locale = ? AND id <> ?", modelContent.TranslationGroupID, modelContent.Locale, modelContent.ID).
+			Count(&count).Error
+		if err != nil {
+			return "", err
+		}
+		if count > 0 {
+			return "a translation in this locale already exists", nil
+		}
+
+		return "", tx.Unscoped().Model(&model.Content{}).Where("id = ?", modelContent.ID).Update("deleted_at", nil).Error
+	}
+
+	if deleted {
+		return "content is deleted", nil
+	}
+
+	updates := map[string]interface{}{
+		"version":    modelContent.Version + 1,
+		"updated_at": time.Now(),
+	}
+
+	switch req.Action {
+	case entity.BulkActionStatus:
+		updates["status"] = req.Status
+	case entity.BulkActionCategory:
+		updates["category_id"] = req.CategoryID
+	case entity.BulkActionAddTags:
+		updates["tags"] = strings.Join(addTags(strings.Split(modelContent.Tags, ","), req.Tags), ",")
+	case entity.BulkActionRemoveTags:
+		updates["tags"] = strings.Join(removeTags(strings.Split(modelContent.Tags, ","), req.Tags), ",")
+	}
+
+	return "", tx.Model(&model.Content{}).Where("id = ?", modelContent.ID).Updates(updates).Error
+}
+
+// addTags appends the tags that are not present yet, ignoring case.
+func addTags(tags, add []string) []string {
+	resps := removeTags(tags, nil)
+	for _, tag := range add {
+		if len(removeTags([]string{tag}, resps)) > 0 {
+			resps = append(resps, strings.TrimSpace(tag))
+		}
+	}
+
+	return resps
+}
+
+// removeTags drops the tags found in remove, ignoring case, and empty ones.
+func removeTags(tags, remove []string) []string {
+	resps := []string{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		found := false
+		for _, val := range remove {
+			if strings.EqualFold(tag, strings.TrimSpace(val)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			resps = append(resps, tag)
+		}
+	}
+
+	return resps
+}
+
+// SetContentAuthors implements ContentRepository. The content's credits are
+// replaced by authors, failing with entity.ErrAuthorNotFound when one of the
+// users does not exist.
+func (c *contentRepository) SetContentAuthors(ctx context.Context, contentID int64, authors []entity.ContentAuthorEntity) error {
+	userIDs := []int64{}
+	for _, val := range authors {
+		userIDs = append(userIDs, val.User.ID)
+	}
+
+	err = c.db.Transaction(func(tx *gorm.DB) error {
+		var found int64
+		if err := tx.Model(&model.User{}).Where("id IN ?", userIDs).Count(&found).Error; err != nil {
+			return err
+		}
+		if found != int64(len(userIDs)) {
+			return entity.ErrAuthorNotFound
+		}
+
+		return setContentAuthors(tx, contentID, authors)
+	})
+	if err != nil && !errors.Is(err, entity.ErrAuthorNotFound) {
+		code = "[REPOSITORY] SetContentAuthors - 1"
+		log.Errorw(code, err)
+	}
+
+	return err
+}
+
 func NewContentRepository(db *gorm.DB) ContentRepository {
 	return &contentRepository{
 		db: db,
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+type DashboardRepository interface {
+	GetStatusCounts(ctx context.Context) ([]entity.StatusCountEntity, error)
+	GetCategoryCounts(ctx context.Context) ([]entity.CategoryCountEntity, error)
+	GetAuthorCounts(ctx context.Context) ([]entity.AuthorCountEntity, error)
+	GetRecentActivity(ctx context.Context, limit int) ([]entity.ActivityEntity, error)
+	GetStaleDrafts(ctx context.Context, before time.Time) ([]entity.ContentEntity, error)
+	GetCadence(ctx context.Context, interval string, periods int) ([]entity.CadenceEntity, error)
+	CountPendingComments(ctx context.Context) (int64, error)
+}
+
+type dashboardRepository struct {
+	db *gorm.DB
+}
+
+// GetStatusCounts implements DashboardRepository.
+func (d *dashboardRepository) GetStatusCounts(ctx context.Context) ([]entity.StatusCountEntity, error) {
+	resps := []entity.StatusCountEntity{}
+
+	err = d.db.Model(&model.Content{}).
+		Select("status, COUNT(*) AS total").
+		Group("status").
+		Order("status ASC").
+		Scan(&resps).Error
+	if err != nil {
+		code = "[REPOSITORY] GetStatusCounts - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return resps, nil
+}
+
+// GetCategoryCounts implements DashboardRepository.
+func (d *dashboardRepository) GetCategoryCounts(ctx context.Context) ([]entity.CategoryCountEntity, error) {
+	resps := []entity.CategoryCountEntity{}
+
+	err = d.db.Table("categories").
+		Select("categories.id AS category_id, categories.title, COUNT(contents.id) AS total, COUNT(contents.id) FILTER (WHERE contents.status = ?) AS published", "PUBLISH").
+		Joins("LEFT JOIN contents ON contents.category_id = categories.id AND contents.deleted_at IS NULL").
+		Group("categories.id, categories.title").
+		Order("total DESC, categories.title ASC").
+		Scan(&resps).Error
+	if err != nil {
+		code = "[REPOSITORY] GetCategoryCounts - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return resps, nil
+}
+
+// GetAuthorCounts implements DashboardRepository.
+func (d *dashboardRepository) GetAuthorCounts(ctx context.Context) ([]entity.AuthorCountEntity, error) {
+	resps := []entity.AuthorCountEntity{}
+
+	err = d.db.Table("users").
+		Select("users.id AS user_id, users.name, COUNT(contents.id) AS total, COUNT(contents.id) FILTER (WHERE contents.status = ?) AS published", "PUBLISH").
+		Joins("LEFT JOIN content_authors ON content_authors.user_id = users.id").
+		Joins("LEFT JOIN contents ON contents.id = content_authors.content_id AND contents.deleted_at IS NULL").
+		Group("users.id, users.name").
+		Order("total DESC, users.name ASC").
+		Scan(&resps).Error
+	if err != nil {
+		code = "[REPOSITORY] GetAuthorCounts - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return resps, nil
+}
+
+// GetRecentActivity implements DashboardRepository. It merges content
+// creation, content edits and incoming comments into one timeline.
+func (d *dashboardRepository) GetRecentActivity(ctx context.Context, limit int) ([]entity.ActivityEntity, error) {
+	resps := []entity.ActivityEntity{}
+
+	err = d.db.Raw(`
+		SELECT * FROM (
+			SELECT 'content_created' AS type, contents.id AS content_id, contents.title, users.name AS actor, contents.status, contents.created_at AS at
+			FROM contents JOIN users ON users.id = contents.user_id
+			WHERE contents.deleted_at IS NULL
+			UNION ALL
+			SELECT 'content_updated', contents.id, contents.title, users.name, contents.status, contents.updated_at
+			FROM contents JOIN users ON users.id = contents.user_id
+			WHERE contents.deleted_at IS NULL AND contents.updated_at > contents.created_at + INTERVAL '1 minute'
+			UNION ALL
+			SELECT 'comment', comments.content_id, contents.title, comments.name, comments.status, comments.created_at
+			FROM comments JOIN contents ON contents.id = comments.content_id
+			WHERE contents.deleted_at IS NULL
+		) AS activity
+		ORDER BY at DESC
+		LIMIT ?`, limit).
+		Scan(&resps).Error
+	if err != nil {
+		code = "[REPOSITORY] GetRecentActivity - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return resps, nil
+}
+
+// GetStaleDrafts implements DashboardRepository.
+func (d *dashboardRepository) GetStaleDrafts(ctx context.Context, before time.Time) ([]entity.ContentEntity, error) {
+	var modelContents []model.Content
+
+	err = d.db.Where("status = ? AND COALESCE(updated_at, created_at) < ?", "DRAFT", before).
+		Preload("User").
+		Order("COALESCE(updated_at, created_at) ASC").
+		Find(&modelContents).Error
+	if err != nil {
+		code = "[REPOSITORY] GetStaleDrafts - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resps := []entity.ContentEntity{}
+	for _, val := range modelContents {
+		updatedAt := val.CreatedAt
+		if val.UpdatedAt != nil {
+			updatedAt = *val.UpdatedAt
+		}
+
+		resp := entity.ContentEntity{
+			ID:        val.ID,
+			Title:     val.Title,
+			Slug:      val.Slug,
+			Status:    val.Status,
+			UserID:    val.UserID,
+			CreatedAt: val.CreatedAt,
+			UpdatedAt: updatedAt,
+			User: entity.UserEntity{
+				ID:   val.User.ID,
+				Name: val.User.Name,
+			},
+		}
+
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
+
+// GetCadence implements DashboardRepository. interval must be "week" or
+// "month"; every period is returned, including those without posts.
+func (d *dashboardRepository) GetCadence(ctx context.Context, interval string, periods int) ([]entity.CadenceEntity, error) {
+	if interval != "week" && interval != "month" {
+		return nil, fmt.Errorf("invalid interval %q", interval)
+	}
+
+	resps := []entity.CadenceEntity{}
+
+	step := fmt.Sprintf("INTERVAL '1 %s'", interval)
+	err = d.db.Raw(fmt.Sprintf(`
+		SELECT series.period, COUNT(contents.id) AS total
+		FROM generate_series(date_trunc('%[1]s', now()) - (? - 1) * %[2]s, date_trunc('%[1]s', now()), %[2]s) AS series(period)
+		LEFT JOIN contents ON contents.status = ? AND contents.deleted_at IS NULL AND date_trunc('%[1]s', contents.created_at) = series.period
+		GROUP BY series.period
+		ORDER BY series.period ASC`, interval, step), periods, "PUBLISH").
+		Scan(&resps).Error
+	if err != nil {
+		code = "[REPOSITORY] GetCadence - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return resps, nil
+}
+
+// CountPendingComments implements DashboardRepository.
+func (d *dashboardRepository) CountPendingComments(ctx context.Context) (int64, error) {
+	var count int64
+
+	err = d.db.Model(&model.Comment{}).Where("status = ?", entity.CommentStatusPending).Count(&count).Error
+	if err != nil {
+		code = "[REPOSITORY] CountPendingComments - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func NewDashboardRepository(db *gorm.DB) DashboardRepository {
+	return &dashboardRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+type LockRepository interface {
+	GetLock(ctx context.Context, contentID int64) (*entity.ContentLockEntity, error)
+	AcquireLock(ctx context.Context, contentID, userID int64, expiresAt time.Time, force bool) (bool, error)
+	RefreshLock(ctx context.Context, contentID, userID int64, expiresAt time.Time) (bool, error)
+	ReleaseLock(ctx context.Context, contentID, userID int64) error
+}
+
+type lockRepository struct {
+	db *gorm.DB
+}
+
+// contentLock returns the unexpired lock of a content, or nil when it is free.
+func contentLock(db *gorm.DB, contentID int64) (*entity.ContentLockEntity, error) {
+	var modelLock model.ContentLock
+	err := db.Where("content_id = ? AND expires_at > ?", contentID, time.Now()).
+		Preload("User").
+		First(&modelLock).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ContentLockEntity{
+		ContentID:  modelLock.ContentID,
+		AcquiredAt: modelLock.AcquiredAt,
+		ExpiresAt:  modelLock.ExpiresAt,
+		User: entity.UserEntity{
+			ID:   modelLock.User.ID,
+			Name: modelLock.User.Name,
+		},
+	}, nil
+}
+
+// GetLock implements LockRepository.
+func (l *lockRepository) GetLock(ctx context.Context, contentID int64) (*entity.ContentLockEntity, error) {
+	result, err := contentLock(l.db, contentID)
+	if err != nil {
+		code = "[REPOSITORY] GetLock - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AcquireLock implements LockRepository. The lock is taken when it is free,
+// expired, already held by userID or force is set, in a single statement so
+// two editors can't both win. Renewing a held lock keeps its acquired time.
+func (l *lockRepository) AcquireLock(ctx context.Context, contentID, userID int64, expiresAt time.Time, force bool) (bool, error) {
+	now := time.Now()
+	result := l.db.Exec(`INSERT INTO content_locks (content_id, user_id, acquired_at, expires_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (content_id) DO UPDATE SET
+			user_id = EXCLUDED.user_id,
+			acquired_at = CASE WHEN content_locks.user_id = EXCLUDED.user_id AND content_locks.expires_at > ? THEN content_locks.acquired_at ELSE EXCLUDED.acquired_at END,
+			expires_at = EXCLUDED.expires_at
+		WHERE content_locks.user_id = EXCLUDED.user_id OR content_locks.expires_at <= ? OR ?`,
+		contentID, userID, now, expiresAt, now, now, force)
+	if result.Error != nil {
+		code = "[REPOSITORY] AcquireLock - 1"
+		log.Errorw(code, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// RefreshLock implements LockRepository. It only extends an unexpired lock
+// held by userID and reports whether there was one.
+func (l *lockRepository) RefreshLock(ctx context.Context, contentID, userID int64, expiresAt time.Time) (bool, error) {
+	result := l.db.Model(&model.ContentLock{}).
+		Where("content_id = ? AND user_id = ? AND expires_at > ?", contentID, userID, time.Now()).
+		Update("expires_at", expiresAt)
+	if result.Error != nil {
+		code = "[REPOSITORY] RefreshLock - 1"
+		log.Errorw(code, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ReleaseLock implements LockRepository. Releasing a lock held by someone
+// else is a no-op.
+func (l *lockRepository) ReleaseLock(ctx context.Context, contentID, userID int64) error {
+	err = l.db.Where("content_id = ? AND user_id = ?", contentID, userID).
+		Delete(&model.ContentLock{}).Error
+	if err != nil {
+		code = "[REPOSITORY] ReleaseLock - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+func NewLockRepository(db *gorm.DB) LockRepository {
+	return &lockRepository{db: db}
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+type PreviewRepository interface {
+	GetPreviewTokens(ctx context.Context, contentID int64) ([]entity.PreviewTokenEntity, error)
+	GetPreviewTokenByID(ctx context.Context, id int64) (*entity.PreviewTokenEntity, error)
+	CreatePreviewToken(ctx context.Context, req entity.PreviewTokenEntity) (int64, error)
+	RevokePreviewToken(ctx context.Context, contentID, id int64) error
+}
+
+type previewRepository struct {
+	db *gorm.DB
+}
+
+// CreatePreviewToken implements PreviewRepository.
+func (p *previewRepository) CreatePreviewToken(ctx context.Context, req entity.PreviewTokenEntity) (int64, error) {
+	modelToken := model.PreviewToken{
+		ContentID: req.ContentID,
+		UserID:    req.User.ID,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	err = p.db.Create(&modelToken).Error
+	if err != nil {
+		code = "[REPOSITORY] CreatePreviewToken - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return modelToken.ID, nil
+}
+
+// GetPreviewTokenByID implements PreviewRepository.
+func (p *previewRepository) GetPreviewTokenByID(ctx context.Context, id int64) (*entity.PreviewTokenEntity, error) {
+	var modelToken model.PreviewToken
+	err = p.db.Where("id = ?", id).First(&modelToken).Error
+	if err != nil {
+		code = "[REPOSITORY] GetPreviewTokenByID - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return &entity.PreviewTokenEntity{
+		ID:        modelToken.ID,
+		ContentID: modelToken.ContentID,
+		ExpiresAt: modelToken.ExpiresAt,
+		RevokedAt: modelToken.RevokedAt,
+		CreatedAt: modelToken.CreatedAt,
+		User: entity.UserEntity{
+			ID: modelToken.UserID,
+		},
+	}, nil
+}
+
+// GetPreviewTokens implements PreviewRepository.
+func (p *previewRepository) GetPreviewTokens(ctx context.Context, contentID int64) ([]entity.PreviewTokenEntity, error) {
+	var modelTokens []model.PreviewToken
+	err = p.db.Where("content_id = ?", contentID).
+		Preload("User").
+		Order("created_at DESC").
+		Find(&modelTokens).Error
+	if err != nil {
+		code = "[REPOSITORY] GetPreviewTokens - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resps := []entity.PreviewTokenEntity{}
+	for _, val := range modelTokens {
+		resps = append(resps, entity.PreviewTokenEntity{
+			ID:        val.ID,
+			ContentID: val.ContentID,
+			ExpiresAt: val.ExpiresAt,
+			RevokedAt: val.RevokedAt,
+			CreatedAt: val.CreatedAt,
+			User: entity.UserEntity{
+				ID:   val.User.ID,
+				Name: val.User.Name,
+			},
+		})
+	}
+
+	return resps, nil
+}
+
+// RevokePreviewToken implements PreviewRepository. Revoking an already
+// revoked token keeps its original revocation time.
+func (p *previewRepository) RevokePreviewToken(ctx context.Context, contentID, id int64) error {
+	err = p.db.Model(&model.PreviewToken{}).
+		Where("id = ? AND content_id = ?", id, contentID).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", time.Now())).Error
+	if err != nil {
+		code = "[REPOSITORY] RevokePreviewToken - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+func NewPreviewRepository(db *gorm.DB) PreviewRepository {
+	return &previewRepository{db: db}
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReactionRepository interface {
+	AddReaction(ctx context.Context, req entity.ReactionEntity) (bool, error)
+	RemoveReaction(ctx context.Context, req entity.ReactionEntity) (bool, error)
+	GetReactionCounts(ctx context.Context, contentIDs []int64) (map[int64]map[string]int64, error)
+	GetVisitorReactions(ctx context.Context, contentID int64, visitorHash string) ([]string, error)
+}
+
+type reactionRepository struct {
+	db *gorm.DB
+}
+
+// AddReaction implements ReactionRepository. It reports whether the reaction
+// was new; a visitor reacting twice with the same reaction is a no-op. The
+// denormalized counter on contents is updated in the same transaction.
+func (r *reactionRepository) AddReaction(ctx context.Context, req entity.ReactionEntity) (bool, error) {
+	added := false
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		modelReaction := model.ContentReaction{
+			ContentID:   req.ContentID,
+			Reaction:    req.Reaction,
+			VisitorHash: req.VisitorHash,
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&modelReaction)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		added = true
+		return tx.Model(&model.Content{}).
+			Where("id = ?", req.ContentID).
+			UpdateColumn("reaction_count", gorm.Expr("reaction_count + 1")).Error
+	})
+	if err != nil {
+		code = "[REPOSITORY] AddReaction - 1"
+		log.Errorw(code, err)
+		return false, err
+	}
+
+	return added, nil
+}
+
+// RemoveReaction implements ReactionRepository.
+func (r *reactionRepository) RemoveReaction(ctx context.Context, req entity.ReactionEntity) (bool, error) {
+	removed := false
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("content_id = ? AND reaction = ? AND visitor_hash = ?", req.ContentID, req.Reaction, req.VisitorHash).
+			Delete(&model.ContentReaction{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		removed = true
+		return tx.Model(&model.Content{}).
+			Where("id = ? AND reaction_count > 0", req.ContentID).
+			UpdateColumn("reaction_count", gorm.Expr("reaction_count - 1")).Error
+	})
+	if err != nil {
+		code = "[REPOSITORY] RemoveReaction - 1"
+		log.Errorw(code, err)
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// GetReactionCounts implements ReactionRepository.
+func (r *reactionRepository) GetReactionCounts(ctx context.Context, contentIDs []int64) (map[int64]map[string]int64, error) {
+	counts, err := reactionCounts(r.db, contentIDs)
+	if err != nil {
+		code = "[REPOSITORY] GetReactionCounts - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// GetVisitorReactions implements ReactionRepository.
+func (r *reactionRepository) GetVisitorReactions(ctx context.Context, contentID int64, visitorHash string) ([]string, error) {
+	reactions := []string{}
+
+	err = r.db.Model(&model.ContentReaction{}).
+		Where("content_id = ? AND visitor_hash = ?", contentID, visitorHash).
+		Order("reaction ASC").
+		Pluck("reaction", &reactions).Error
+	if err != nil {
+		code = "[REPOSITORY] GetVisitorReactions - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return reactions, nil
+}
+
+// reactionCounts returns the number of each reaction per content. Every
+// requested content gets an entry, even when nobody has reacted yet.
+func reactionCounts(db *gorm.DB, contentIDs []int64) (map[int64]map[string]int64, error) {
+	counts := make(map[int64]map[string]int64, len(contentIDs))
+	for _, id := range contentIDs {
+		counts[id] = map[string]int64{}
+	}
+
+	if len(contentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ContentID int64
+		Reaction  string
+		Total     int64
+	}
+
+	err := db.Model(&model.ContentReaction{}).
+		Select("content_id, reaction, COUNT(*) AS total").
+		Where("content_id IN ?", contentIDs).
+		Group("content_id, reaction").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ContentID][row.Reaction] = row.Total
+	}
+
+	return counts, nil
+}
+
+func NewReactionRepository(db *gorm.DB) ReactionRepository {
+	return &reactionRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+type SeriesRepository interface {
+	GetSeries(ctx context.Context, publishedOnly bool) ([]entity.SeriesEntity, error)
+	GetSeriesByID(ctx context.Context, id int64) (*entity.SeriesEntity, error)
+	GetSeriesBySlug(ctx context.Context, slug string) (*entity.SeriesEntity, error)
+	CreateSeries(ctx context.Context, req entity.SeriesEntity) (int64, error)
+	EditSeriesByID(ctx context.Context, req entity.SeriesEntity) error
+	DeleteSeries(ctx context.Context, id int64) error
+	SetSeriesContents(ctx context.Context, seriesID int64, contentIDs []int64) error
+}
+
+type seriesRepository struct {
+	db *gorm.DB
+}
+
+// withPartCount selects series together with their number of parts. When
+// publishedOnly is set, draft parts are not counted.
+func withPartCount(db *gorm.DB, publishedOnly bool) *gorm.DB {
+	if publishedOnly {
+		return db.Model(&model.Series{}).
+			Select("series.*, (SELECT COUNT(*) FROM series_contents JOIN contents ON contents.id = series_contents.content_id WHERE series_contents.series_id = series.id AND contents.status = ? AND contents.deleted_at IS NULL) AS part_count", "PUBLISH")
+	}
+
+	return db.Model(&model.Series{}).
+		Select("series.*, (SELECT COUNT(*) FROM series_contents JOIN contents ON contents.id = series_contents.content_id WHERE series_contents.series_id = series.id AND contents.deleted_at IS NULL) AS part_count")
+}
+
+// seriesParts loads the contents of a series in reading order.
+func seriesParts(db *gorm.DB, seriesID int64, publishedOnly bool) ([]model.Content, error) {
+	var modelContents []model.Content
+
+	sql := db.Joins("JOIN series_contents ON series_contents.content_id = contents.id").
+		Where("series_contents.series_id = ?", seriesID)
+	if publishedOnly {
+		sql = sql.Where("contents.status = ?", "PUBLISH")
+	}
+
+	err := sql.Preload("Category").Preload("User").
+		Order("series_contents.position ASC").
+		Find(&modelContents).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return modelContents, nil
+}
+
+// seriesNav places a content within its series. Only published parts are
+// counted, plus the content itself so a draft can be previewed in place.
+// It returns nil when the content is not part of any series.
+func seriesNav(db *gorm.DB, contentID int64) (*entity.SeriesNavEntity, error) {
+	var membership model.SeriesContent
+	err := db.Where("content_id = ?", contentID).First(&membership).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var modelSeries model.Series
+	err = db.Where("id = ?", membership.SeriesID).First(&modelSeries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []model.Content
+	err = db.Select("contents.id, contents.title, contents.slug").
+		Joins("JOIN series_contents ON series_contents.content_id = contents.id").
+		Where("series_contents.series_id = ? AND (contents.status = ? OR contents.id = ?)", modelSeries.ID, "PUBLISH", contentID).
+		Order("series_contents.position ASC").
+		Find(&parts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	nav := entity.SeriesNavEntity{
+		ID:    modelSeries.ID,
+		Title: modelSeries.Title,
+		Slug:  modelSeries.Slug,
+		Total: len(parts),
+	}
+
+	for i, val := range parts {
+		if val.ID != contentID {
+			continue
+		}
+
+		nav.Part = i + 1
+		if i > 0 {
+			nav.Previous = &entity.ContentEntity{ID: parts[i-1].ID, Title: parts[i-1].Title, Slug: parts[i-1].Slug}
+		}
+		if i < len(parts)-1 {
+			nav.Next = &entity.ContentEntity{ID: parts[i+1].ID, Title: parts[i+1].Title, Slug: parts[i+1].Slug}
+		}
+	}
+
+	return &nav, nil
+}
+
+// GetSeries implements SeriesRepository.
+func (s *seriesRepository) GetSeries(ctx context.Context, publishedOnly bool) ([]entity.SeriesEntity, error) {
+	var modelSeries []model.Series
+
+	sql := withPartCount(s.db, publishedOnly)
+	if publishedOnly {
+		sql = sql.Where("EXISTS (SELECT 1 FROM series_contents JOIN contents ON contents.id = series_contents.content_id WHERE series_contents.series_id = series.id AND contents.status = ? AND contents.deleted_at IS NULL)", "PUBLISH")
+	}
+
+	err = sql.Preload("User").Order("created_at DESC").Find(&modelSeries).Error
+	if err != nil {
+		code = "[REPOSITORY] GetSeries - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resps := []entity.SeriesEntity{}
+	for _, val := range modelSeries {
+		resps = append(resps, entity.SeriesEntity{
+			ID:          val.ID,
+			Title:       val.Title,
+			Slug:        val.Slug,
+			Description: val.Description,
+			UserID:      val.UserID,
+			PartCount:   val.PartCount,
+			CreatedAt:   val.CreatedAt,
+			User: entity.UserEntity{
+				ID:   val.User.ID,
+				Name: val.User.Name,
+			},
+		})
+	}
+
+	return resps, nil
+}
+
+// GetSeriesByID implements SeriesRepository. All parts are returned,
+// whatever their status.
+func (s *seriesRepository) GetSeriesByID(ctx context.Context, id int64) (*entity.SeriesEntity, error) {
+	var modelSeries model.Series
+
+	err = withPartCount(s.db, false).Where("id = ?", id).Preload("User").First(&modelSeries).Error
+	if err != nil {
+		code = "[REPOSITORY] GetSeriesByID - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	parts, err := seriesParts(s.db, modelSeries.ID, false)
+	if err != nil {
+		code = "[REPOSITORY] GetSeriesByID - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return seriesEntity(modelSeries, parts), nil
+}
+
+// GetSeriesBySlug implements SeriesRepository. Only published parts are
+// returned.
+func (s *seriesRepository) GetSeriesBySlug(ctx context.Context, slug string) (*entity.SeriesEntity, error) {
+	var modelSeries model.Series
+
+	err = withPartCount(s.db, true).Where("slug = ?", slug).Preload("User").First(&modelSeries).Error
+	if err != nil {
+		code = "[REPOSITORY] GetSeriesBySlug - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	parts, err := seriesParts(s.db, modelSeries.ID, true)
+	if err != nil {
+		code = "[REPOSITORY] GetSeriesBySlug - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return seriesEntity(modelSeries, parts), nil
+}
+
+func seriesEntity(modelSeries model.Series, parts []model.Content) *entity.SeriesEntity {
+	resp := entity.SeriesEntity{
+		ID:          modelSeries.ID,
+		Title:       modelSeries.Title,
+		Slug:        modelSeries.Slug,
+		Description: modelSeries.Description,
+		UserID:      modelSeries.UserID,
+		PartCount:   modelSeries.PartCount,
+		CreatedAt:   modelSeries.CreatedAt,
+		User: entity.UserEntity{
+			ID:   modelSeries.User.ID,
+			Name: modelSeries.User.Name,
+		},
+		Parts: []entity.ContentEntity{},
+	}
+
+	for _, val := range parts {
+		resp.Parts = append(resp.Parts, entity.ContentEntity{
+			ID:         val.ID,
+			Title:      val.Title,
+			Slug:       val.Slug,
+			Excerpt:    val.Excerpt,
+			Image:      val.Image,
+			Status:     val.Status,
+			CategoryID: val.CategoryID,
+			UserID:     val.UserID,
+			CreatedAt:  val.CreatedAt,
+			Category: entity.CategoryEntity{
+				ID:    val.Category.ID,
+				Title: val.Category.Title,
+				Slug:  val.Category.Slug,
+			},
+			User: entity.UserEntity{
+				ID:   val.User.ID,
+				Name: val.User.Name,
+			},
+		})
+	}
+
+	return &resp
+}
+
+// CreateSeries implements SeriesRepository.
+func (s *seriesRepository) CreateSeries(ctx context.Context, req entity.SeriesEntity) (int64, error) {
+	modelSeries := model.Series{
+		Title:       req.Title,
+		Description: req.Description,
+		UserID:      req.UserID,
+	}
+
+	err = writeWithSlug(s.db, "series", req.Slug, 0, func(slug string) error {
+		modelSeries.ID = 0
+		modelSeries.Slug = slug
+		return s.db.Create(&modelSeries).Error
+	})
+	if err != nil {
+		code = "[REPOSITORY] CreateSeries - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return modelSeries.ID, nil
+}
+
+// EditSeriesByID implements SeriesRepository.
+func (s *seriesRepository) EditSeriesByID(ctx context.Context, req entity.SeriesEntity) error {
+	modelSeries := model.Series{
+		Title:       req.Title,
+		Description: req.Description,
+	}
+
+	err = writeWithSlug(s.db, "series", req.Slug, req.ID, func(slug string) error {
+		modelSeries.Slug = slug
+		return s.db.Where("id = ?", req.ID).
+			Select("title", "slug", "description", "updated_at").
+			Updates(&modelSeries).Error
+	})
+	if err != nil {
+		code = "[REPOSITORY] EditSeriesByID - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSeries implements SeriesRepository. Its contents are kept and
+// simply leave the series.
+func (s *seriesRepository) DeleteSeries(ctx context.Context, id int64) error {
+	err = s.db.Where("id = ?", id).Delete(&model.Series{}).Error
+	if err != nil {
+		code = "[REPOSITORY] DeleteSeries - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// SetSeriesContents implements SeriesRepository. The given contents become
+// the parts of the series in that order; a content can only belong to one
+// series, so it is moved out of any other series first.
+func (s *seriesRepository) SetSeriesContents(ctx context.Context, seriesID int64, contentIDs []int64) error {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("series_id = ?", seriesID).Delete(&model.SeriesContent{}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(contentIDs) == 0 {
+			return nil
+		}
+
+		err = tx.Where("content_id IN ?", contentIDs).Delete(&model.SeriesContent{}).Error
+		if err != nil {
+			return err
+		}
+
+		modelParts := []model.SeriesContent{}
+		for i, contentID := range contentIDs {
+			modelParts = append(modelParts, model.SeriesContent{
+				SeriesID:  seriesID,
+				ContentID: contentID,
+				Position:  i + 1,
+			})
+		}
+
+		return tx.Create(&modelParts).Error
+	})
+	if err != nil {
+		code = "[REPOSITORY] SetSeriesContents - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+func NewSeriesRepository(db *gorm.DB) SeriesRepository {
+	return &seriesRepository{
+		db: db,
+	}
+}
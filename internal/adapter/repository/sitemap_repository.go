@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+type SitemapRepository interface {
+	CountContents(ctx context.Context, excludeNoIndex bool) (int64, error)
+	GetContents(ctx context.Context, offset, limit int, excludeNoIndex bool) ([]entity.SitemapItemEntity, error)
+	GetCategories(ctx context.Context) ([]entity.SitemapItemEntity, error)
+	GetTags(ctx context.Context) ([]entity.SitemapItemEntity, error)
+	GetPageDependencies(ctx context.Context) (string, error)
+}
+
+type sitemapRepository struct {
+	db *gorm.DB
+}
+
+type sitemapRow struct {
+	Slug      string
+	Image     string
+	UpdatedAt time.Time
+}
+
+// CountContents implements SitemapRepository.
+func (s *sitemapRepository) CountContents(ctx context.Context, excludeNoIndex bool) (int64, error) {
+	var count int64
+	err = publishedContents(s.db, excludeNoIndex).Count(&count).Error
+	if err != nil {
+		code = "[REPOSITORY] CountContents - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetContents implements SitemapRepository.
+func (s *sitemapRepository) GetContents(ctx context.Context, offset, limit int, excludeNoIndex bool) ([]entity.SitemapItemEntity, error) {
+	var rows []sitemapRow
+	err = publishedContents(s.db, excludeNoIndex).
+		Select("slug, COALESCE(image, '') AS image, COALESCE(updated_at, created_at) AS updated_at").
+		Order("id ASC").
+		Offset(offset).
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		code = "[REPOSITORY] GetContents - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return toSitemapItems(rows), nil
+}
+
+// GetPageDependencies implements SitemapRepository. It digests what a post
+// page shows besides the post itself: the category navigation, author
+// profiles and credits, series and the contents linked as series parts or
+// translations. The digest changes whenever any of them does.
+func (s *sitemapRepository) GetPageDependencies(ctx context.Context) (string, error) {
+	var digest string
+	err = s.db.Raw(`
+		SELECT md5(concat_ws('|',
+			(SELECT string_agg(categories::text, ',' ORDER BY id) FROM categories),
+			(SELECT string_agg(concat_ws(':', id, name, display_name, slug, avatar), ',' ORDER BY id) FROM users),
+			(SELECT string_agg(content_authors::text, ',' ORDER BY content_id, position) FROM content_authors),
+			(SELECT string_agg(series::text, ',' ORDER BY id) FROM series),
+			(SELECT string_agg(series_contents::text, ',' ORDER BY series_id, position) FROM series_contents),
+			(SELECT string_agg(concat_ws(':', id, slug, title, locale, status, translation_group_id), ',' ORDER BY id)
+				FROM contents
+				WHERE deleted_at IS NULL AND (
+					id IN (SELECT content_id FROM series_contents)
+					OR translation_group_id IN (SELECT translation_group_id FROM contents WHERE deleted_at IS NULL GROUP BY translation_group_id HAVING COUNT(*) > 1)
+				))
+		))`).
+		Scan(&digest).Error
+	if err != nil {
+		code = "[REPOSITORY] GetPageDependencies - 1"
+		log.Errorw(code, err)
+		return "", err
+	}
+
+	return digest, nil
+}
+
+// publishedContents selects the live published contents. Sitemaps leave out
+// the noindex ones, while the static export still renders them.
+func publishedContents(db *gorm.DB, excludeNoIndex bool) *gorm.DB {
+	sql := db.Table("contents").Where("status = ? AND deleted_at IS NULL", "PUBLISH")
+	if excludeNoIndex {
+		sql = sql.Where("NOT no_index")
+	}
+
+	return sql
+}
+
+// GetCategories implements SitemapRepository.
+func (s *sitemapRepository) GetCategories(ctx context.Context) ([]entity.SitemapItemEntity, error) {
+	var rows []sitemapRow
+	err = s.db.Table("categories").
+		Select("slug, COALESCE(image, '') AS image, COALESCE(updated_at, created_at) AS updated_at").
+		Where("EXISTS (SELECT 1 FROM contents WHERE contents.category_id = categories.id AND contents.status = ? AND contents.deleted_at IS NULL)", "PUBLISH").
+		Order("display_order ASC, id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		code = "[REPOSITORY] GetCategories - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return toSitemapItems(rows), nil
+}
+
+// GetTags implements SitemapRepository.
+func (s *sitemapRepository) GetTags(ctx context.Context) ([]entity.SitemapItemEntity, error) {
+	var rows []sitemapRow
+	err = s.db.Raw(`SELECT lower(trim(tag)) AS slug, MAX(COALESCE(contents.updated_at, contents.created_at)) AS updated_at
+		FROM contents, unnest(string_to_array(contents.tags, ',')) AS tag
+		WHERE contents.status = ? AND contents.deleted_at IS NULL AND trim(tag) <> ''
+		GROUP BY lower(trim(tag))
+		ORDER BY slug ASC`, "PUBLISH").
+		Scan(&rows).Error
+	if err != nil {
+		code = "[REPOSITORY] GetTags - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return toSitemapItems(rows), nil
+}
+
+func toSitemapItems(rows []sitemapRow) []entity.SitemapItemEntity {
+	var resps []entity.SitemapItemEntity
+	for _, val := range rows {
+		resps = append(resps, entity.SitemapItemEntity{
+			Slug:      val.Slug,
+			Image:     val.Image,
+			UpdatedAt: val.UpdatedAt,
+		})
+	}
+
+	return resps
+}
+
+func NewSitemapRepository(db *gorm.DB) SitemapRepository {
+	return &sitemapRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"blog/lib/conv"
+	"errors"
+	"slices"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// slugBatchSize is how many slug variants are checked per query.
+	slugBatchSize = 20
+	// slugRetries bounds how often a write is retried after a concurrent
+	// one took the slug it picked.
+	slugRetries = 3
+)
+
+// uniqueSlug returns slug or the first free numbered variant of it in table,
+// ignoring the row identified by excludeID so an edit keeps its own slug.
+// The variants are looked up as they are built, since a long slug is cut to
+// make room for the suffix and no longer shares a prefix with them.
+func uniqueSlug(db *gorm.DB, table string, slug string, excludeID int64) (string, error) {
+	var taken []string
+
+	for n := 1; ; n += slugBatchSize {
+		candidates := []string{}
+		for i := n; i < n+slugBatchSize; i++ {
+			candidates = append(candidates, conv.SlugCandidate(slug, i))
+		}
+
+		sql := db.Table(table).Where("slug IN ?", candidates)
+		if excludeID > 0 {
+			sql = sql.Where("id <> ?", excludeID)
+		}
+
+		var found []string
+		err := sql.Pluck("slug", &found).Error
+		if err != nil {
+			code = "[REPOSITORY] uniqueSlug - 1"
+			log.Errorw(code, err)
+			return "", err
+		}
+		taken = append(taken, found...)
+
+		if free := conv.UniqueSlug(slug, taken); slices.Contains(candidates, free) {
+			return free, nil
+		}
+	}
+}
+
+// writeWithSlug hands write a free variant of slug, picking another one when
+// the write fails on a unique index because a concurrent write took it
+// first. write must not run inside a transaction it does not own, as the
+// failed statement aborts it.
+func writeWithSlug(db *gorm.DB, table string, slug string, excludeID int64, write func(slug string) error) error {
+	var err error
+	for attempt := 0; attempt < slugRetries; attempt++ {
+		var free string
+		free, err = uniqueSlug(db, table, slug, excludeID)
+		if err != nil {
+			return err
+		}
+
+		err = write(free)
+		if err == nil || !isDuplicatedKey(db, err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// isDuplicatedKey reports whether err is a unique constraint violation.
+func isDuplicatedKey(db *gorm.DB, err error) bool {
+	if translator, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
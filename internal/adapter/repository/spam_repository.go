@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SpamRepository interface {
+	GetBlocklist(ctx context.Context) ([]entity.SpamBlocklistEntity, error)
+	CreateBlocklist(ctx context.Context, req []entity.SpamBlocklistEntity) (int64, error)
+	DeleteBlocklist(ctx context.Context, id int64) error
+}
+
+type spamRepository struct {
+	db *gorm.DB
+}
+
+// CreateBlocklist implements SpamRepository. Entries that already exist are skipped.
+func (s *spamRepository) CreateBlocklist(ctx context.Context, req []entity.SpamBlocklistEntity) (int64, error) {
+	if len(req) == 0 {
+		return 0, nil
+	}
+
+	modelBlocklist := []model.SpamBlocklist{}
+	for _, val := range req {
+		modelBlocklist = append(modelBlocklist, model.SpamBlocklist{
+			Kind:   val.Kind,
+			Value:  val.Value,
+			Source: val.Source,
+		})
+	}
+
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&modelBlocklist)
+	if result.Error != nil {
+		code = "[REPOSITORY] CreateBlocklist - 1"
+		log.Errorw(code, result.Error)
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// DeleteBlocklist implements SpamRepository.
+func (s *spamRepository) DeleteBlocklist(ctx context.Context, id int64) error {
+	result := s.db.Where("id = ?", id).Delete(&model.SpamBlocklist{})
+	if result.Error != nil {
+		code = "[REPOSITORY] DeleteBlocklist - 1"
+		log.Errorw(code, result.Error)
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// GetBlocklist implements SpamRepository.
+func (s *spamRepository) GetBlocklist(ctx context.Context) ([]entity.SpamBlocklistEntity, error) {
+	var modelBlocklist []model.SpamBlocklist
+
+	err = s.db.Order("kind ASC, value ASC").Find(&modelBlocklist).Error
+	if err != nil {
+		code = "[REPOSITORY] GetBlocklist - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resps := []entity.SpamBlocklistEntity{}
+	for _, val := range modelBlocklist {
+		resps = append(resps, entity.SpamBlocklistEntity{
+			ID:        val.ID,
+			Kind:      val.Kind,
+			Value:     val.Value,
+			Source:    val.Source,
+			CreatedAt: val.CreatedAt,
+		})
+	}
+
+	return resps, nil
+}
+
+func NewSpamRepository(db *gorm.DB) SpamRepository {
+	return &spamRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+type TemplateRepository interface {
+	GetTemplates(ctx context.Context) ([]entity.ContentTemplateEntity, error)
+	GetTemplateByID(ctx context.Context, id int64) (*entity.ContentTemplateEntity, error)
+	CreateTemplate(ctx context.Context, req entity.ContentTemplateEntity) (int64, error)
+	EditTemplateByID(ctx context.Context, req entity.ContentTemplateEntity) error
+	DeleteTemplate(ctx context.Context, id int64) error
+}
+
+type templateRepository struct {
+	db *gorm.DB
+}
+
+// templateModel maps a template to its row; a zero category is stored as
+// NULL.
+func templateModel(req entity.ContentTemplateEntity) model.ContentTemplate {
+	modelTemplate := model.ContentTemplate{
+		Name:         req.Name,
+		TitlePattern: req.TitlePattern,
+		Description:  req.Description,
+		Tags:         strings.Join(req.Tags, ","),
+		UserID:       req.User.ID,
+	}
+	if req.CategoryID > 0 {
+		modelTemplate.CategoryID = &req.CategoryID
+	}
+
+	return modelTemplate
+}
+
+func templateEntity(val model.ContentTemplate) entity.ContentTemplateEntity {
+	updatedAt := val.CreatedAt
+	if val.UpdatedAt != nil {
+		updatedAt = *val.UpdatedAt
+	}
+
+	tags := []string{}
+	if val.Tags != "" {
+		tags = strings.Split(val.Tags, ",")
+	}
+
+	resp := entity.ContentTemplateEntity{
+		ID:           val.ID,
+		Name:         val.Name,
+		TitlePattern: val.TitlePattern,
+		Description:  val.Description,
+		Tags:         tags,
+		CreatedAt:    val.CreatedAt,
+		UpdatedAt:    updatedAt,
+		User: entity.UserEntity{
+			ID:   val.User.ID,
+			Name: val.User.Name,
+		},
+	}
+	if val.Category != nil {
+		resp.CategoryID = val.Category.ID
+		resp.Category = entity.CategoryEntity{
+			ID:    val.Category.ID,
+			Title: val.Category.Title,
+			Slug:  val.Category.Slug,
+		}
+	}
+
+	return resp
+}
+
+// GetTemplates implements TemplateRepository.
+func (t *templateRepository) GetTemplates(ctx context.Context) ([]entity.ContentTemplateEntity, error) {
+	var modelTemplates []model.ContentTemplate
+	err = t.db.Preload("User").Preload("Category").Order("name ASC").Find(&modelTemplates).Error
+	if err != nil {
+		code = "[REPOSITORY] GetTemplates - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resps := []entity.ContentTemplateEntity{}
+	for _, val := range modelTemplates {
+		resps = append(resps, templateEntity(val))
+	}
+
+	return resps, nil
+}
+
+// GetTemplateByID implements TemplateRepository.
+func (t *templateRepository) GetTemplateByID(ctx context.Context, id int64) (*entity.ContentTemplateEntity, error) {
+	var modelTemplate model.ContentTemplate
+	err = t.db.Where("id = ?", id).Preload("User").Preload("Category").First(&modelTemplate).Error
+	if err != nil {
+		code = "[REPOSITORY] GetTemplateByID - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resp := templateEntity(modelTemplate)
+
+	return &resp, nil
+}
+
+// CreateTemplate implements TemplateRepository.
+func (t *templateRepository) CreateTemplate(ctx context.Context, req entity.ContentTemplateEntity) (int64, error) {
+	modelTemplate := templateModel(req)
+
+	err = t.db.Create(&modelTemplate).Error
+	if err != nil {
+		code = "[REPOSITORY] CreateTemplate - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return modelTemplate.ID, nil
+}
+
+// EditTemplateByID implements TemplateRepository.
+func (t *templateRepository) EditTemplateByID(ctx context.Context, req entity.ContentTemplateEntity) error {
+	modelTemplate := templateModel(req)
+
+	err = t.db.Where("id = ?", req.ID).
+		Select("name", "title_pattern", "description", "tags", "category_id", "updated_at").
+		Updates(&modelTemplate).Error
+	if err != nil {
+		code = "[REPOSITORY] EditTemplateByID - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTemplate implements TemplateRepository.
+func (t *templateRepository) DeleteTemplate(ctx context.Context, id int64) error {
+	err = t.db.Where("id = ?", id).Delete(&model.ContentTemplate{}).Error
+	if err != nil {
+		code = "[REPOSITORY] DeleteTemplate - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+func NewTemplateRepository(db *gorm.DB) TemplateRepository {
+	return &templateRepository{db: db}
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+type TranslationRepository interface {
+	GetContentGroups(ctx context.Context) ([]entity.TranslationGroupEntity, error)
+	GetCategoryGroups(ctx context.Context) ([]entity.TranslationGroupEntity, error)
+}
+
+type translationRepository struct {
+	db *gorm.DB
+}
+
+// contentTranslations lists the other contents of a translation group.
+func contentTranslations(db *gorm.DB, groupID, id int64) ([]entity.TranslationEntity, error) {
+	var modelContents []model.Content
+	err := db.Select("id, locale, title, slug, status").
+		Where("translation_group_id = ? AND id <> ?", groupID, id).
+		Order("locale ASC").
+		Find(&modelContents).Error
+	if err != nil {
+		return nil, err
+	}
+
+	resps := []entity.TranslationEntity{}
+	for _, val := range modelContents {
+		resps = append(resps, entity.TranslationEntity{
+			ID:     val.ID,
+			Locale: val.Locale,
+			Title:  val.Title,
+			Slug:   val.Slug,
+			Status: val.Status,
+		})
+	}
+
+	return resps, nil
+}
+
+// categoryTranslations lists the other categories of a translation group.
+func categoryTranslations(db *gorm.DB, groupID, id int64) ([]entity.TranslationEntity, error) {
+	var modelCategories []model.Category
+	err := db.Select("id, locale, title, slug").
+		Where("translation_group_id = ? AND id <> ?", groupID, id).
+		Order("locale ASC").
+		Find(&modelCategories).Error
+	if err != nil {
+		return nil, err
+	}
+
+	resps := []entity.TranslationEntity{}
+	for _, val := range modelCategories {
+		resps = append(resps, entity.TranslationEntity{
+			ID:     val.ID,
+			Locale: val.Locale,
+			Title:  val.Title,
+			Slug:   val.Slug,
+		})
+	}
+
+	return resps, nil
+}
+
+// translationGroups lists every translation group of table with the locales
+// it exists in. The oldest row stands for the group.
+func translationGroups(db *gorm.DB, table string) ([]entity.TranslationGroupEntity, error) {
+	var rows []struct {
+		GroupID int64
+		ID      int64
+		Title   string
+		Locales string
+	}
+
+	err := db.Table(table).
+		Select("translation_group_id AS group_id, MIN(id) AS id, (array_agg(title ORDER BY id))[1] AS title, string_agg(locale, ',' ORDER BY locale) AS locales").
+		Group("translation_group_id").
+		Order("group_id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	resps := []entity.TranslationGroupEntity{}
+	for _, val := range rows {
+		resps = append(resps, entity.TranslationGroupEntity{
+			GroupID: val.GroupID,
+			ID:      val.ID,
+			Title:   val.Title,
+			Locales: strings.Split(val.Locales, ","),
+		})
+	}
+
+	return resps, nil
+}
+
+// GetContentGroups implements TranslationRepository.
+func (t *translationRepository) GetContentGroups(ctx context.Context) ([]entity.TranslationGroupEntity, error) {
+	resps, err := translationGroups(t.db.Where("deleted_at IS NULL"), "contents")
+	if err != nil {
+		code = "[REPOSITORY] GetContentGroups - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return resps, nil
+}
+
+// GetCategoryGroups implements TranslationRepository.
+func (t *translationRepository) GetCategoryGroups(ctx context.Context) ([]entity.TranslationGroupEntity, error) {
+	resps, err := translationGroups(t.db, "categories")
+	if err != nil {
+		code = "[REPOSITORY] GetCategoryGroups - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return resps, nil
+}
+
+func NewTranslationRepository(db *gorm.DB) TranslationRepository {
+	return &translationRepository{db: db}
+}
@@ -4,6 +4,7 @@ import (
 	"blog/internal/core/domain/entity"
 	"blog/internal/core/domain/model"
 	"context"
+	"encoding/json"
 
 	"github.com/gofiber/fiber/v2/log"
 	"gorm.io/gorm"
@@ -12,6 +13,9 @@ import (
 type UserRepository interface {
 	UpdatePassword(ctx context.Context, newPass string, id int64) error
 	GetUserById(ctx context.Context, id int64) (*entity.UserEntity, error)
+	GetUserBySlug(ctx context.Context, slug string) (*entity.UserEntity, error)
+	UpdateProfile(ctx context.Context, req entity.UserEntity) error
+	UpdateAvatar(ctx context.Context, avatar string, id int64) error
 }
 
 type userRepository struct {
@@ -28,14 +32,20 @@ func (u *userRepository) GetUserById(ctx context.Context, id int64) (*entity.Use
 		return nil, err
 	}
 
-	resp := entity.UserEntity{
-		ID:       modelUser.ID,
-		Name:     modelUser.Name,
-		Email:    modelUser.Email,
-		Password: modelUser.Password,
+	return userEntity(modelUser), nil
+}
+
+// GetUserBySlug implements UserRepository.
+func (u *userRepository) GetUserBySlug(ctx context.Context, slug string) (*entity.UserEntity, error) {
+	var modelUser model.User
+	err = u.db.Where("slug = ? AND slug <> ''", slug).First(&modelUser).Error
+	if err != nil {
+		code = "[REPOSITORY] GetUserBySlug - 1"
+		log.Errorw(code, err)
+		return nil, err
 	}
 
-	return &resp, nil
+	return userEntity(modelUser), nil
 }
 
 // UpdatePassword implements UserRepository.
@@ -51,6 +61,85 @@ func (u *userRepository) UpdatePassword(ctx context.Context, newPass string, id
 	return nil
 }
 
+// UpdateProfile implements UserRepository. A slug taken by another user gets
+// a numbered suffix.
+func (u *userRepository) UpdateProfile(ctx context.Context, req entity.UserEntity) error {
+	modelUser := model.User{
+		Name:        req.Name,
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+		SocialLinks: encodeSocialLinks(req.SocialLinks),
+	}
+
+	err = writeWithSlug(u.db, "users", req.Slug, req.ID, func(slug string) error {
+		modelUser.Slug = slug
+		return u.db.Where("id = ?", req.ID).
+			Select("name", "display_name", "bio", "social_links", "slug").
+			Updates(&modelUser).Error
+	})
+	if err != nil {
+		code = "[REPOSITORY] UpdateProfile - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateAvatar implements UserRepository.
+func (u *userRepository) UpdateAvatar(ctx context.Context, avatar string, id int64) error {
+	err = u.db.Model(&model.User{}).Where("id = ?", id).Update("avatar", avatar).Error
+	if err != nil {
+		code = "[REPOSITORY] UpdateAvatar - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+func userEntity(modelUser model.User) *entity.UserEntity {
+	return &entity.UserEntity{
+		ID:          modelUser.ID,
+		Name:        modelUser.Name,
+		Email:       modelUser.Email,
+		Password:    modelUser.Password,
+		DisplayName: modelUser.DisplayName,
+		Bio:         modelUser.Bio,
+		Avatar:      modelUser.Avatar,
+		SocialLinks: decodeSocialLinks(modelUser.SocialLinks),
+		Slug:        modelUser.Slug,
+	}
+}
+
+func encodeSocialLinks(links map[string]string) string {
+	if len(links) == 0 {
+		return ""
+	}
+
+	data, err := json.Marshal(links)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// decodeSocialLinks reads the social_links column; users saved before it
+// existed have none.
+func decodeSocialLinks(links string) map[string]string {
+	resps := map[string]string{}
+	if links == "" {
+		return resps
+	}
+
+	if err := json.Unmarshal([]byte(links), &resps); err != nil {
+		return map[string]string{}
+	}
+
+	return resps
+}
+
 func NewUserRepository(db *gorm.DB) UserRepository {
 	return &userRepository{
 		db: db,
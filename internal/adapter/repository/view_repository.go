@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/domain/model"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ViewRepository interface {
+	SaveViews(ctx context.Context, req []entity.ViewDailyEntity) error
+	GetPopularContents(ctx context.Context, since time.Time, limit int) ([]entity.PopularContentEntity, error)
+	GetContentViews(ctx context.Context, contentID int64, since time.Time) (*entity.ViewStatsEntity, error)
+}
+
+type viewRepository struct {
+	db *gorm.DB
+}
+
+// SaveViews implements ViewRepository. Daily rows are upserted and the
+// lifetime counter on contents is incremented in a single transaction.
+// Views of contents that were deleted meanwhile are dropped.
+func (v *viewRepository) SaveViews(ctx context.Context, req []entity.ViewDailyEntity) error {
+	if len(req) == 0 {
+		return nil
+	}
+
+	contentIDs := []int64{}
+	for _, val := range req {
+		contentIDs = append(contentIDs, val.ContentID)
+	}
+
+	err := v.db.Transaction(func(tx *gorm.DB) error {
+		// the share lock keeps the contents from being deleted before the insert
+		var existing []int64
+		err := tx.Model(&model.Content{}).Unscoped().
+			Clauses(clause.Locking{Strength: "SHARE"}).
+			Where("id IN ?", contentIDs).
+			Pluck("id", &existing).Error
+		if err != nil {
+			return err
+		}
+
+		found := map[int64]bool{}
+		for _, id := range existing {
+			found[id] = true
+		}
+
+		modelViews := []model.ContentViewDaily{}
+		totals := map[int64]int64{}
+		for _, val := range req {
+			if !found[val.ContentID] {
+				continue
+			}
+
+			modelViews = append(modelViews, model.ContentViewDaily{
+				ContentID:   val.ContentID,
+				Day:         val.Day,
+				Views:       val.Views,
+				UniqueViews: val.UniqueViews,
+			})
+			totals[val.ContentID] += val.Views
+		}
+
+		if len(modelViews) == 0 {
+			return nil
+		}
+
+		err = tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "content_id"}, {Name: "day"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"views":        gorm.Expr("content_view_daily.views + excluded.views"),
+				"unique_views": gorm.Expr("content_view_daily.unique_views + excluded.unique_views"),
+			}),
+		}).Create(&modelViews).Error
+		if err != nil {
+			return err
+		}
+
+		for contentID, views := range totals {
+			err = tx.Model(&model.Content{}).
+				Where("id = ?", contentID).
+				UpdateColumn("view_count", gorm.Expr("view_count + ?", views)).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		code = "[REPOSITORY] SaveViews - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetPopularContents implements ViewRepository.
+func (v *viewRepository) GetPopularContents(ctx context.Context, since time.Time, limit int) ([]entity.PopularContentEntity, error) {
+	var ranked []struct {
+		ContentID int64
+		Views     int64
+	}
+
+	err = v.db.Model(&model.ContentViewDaily{}).
+		Select("content_view_daily.content_id, SUM(content_view_daily.views) AS views").
+		Joins("JOIN contents ON contents.id = content_view_daily.content_id").
+		Where("contents.status = ? AND contents.deleted_at IS NULL AND content_view_daily.day >= ?", "PUBLISH", since).
+		Group("content_view_daily.content_id").
+		Order("views DESC, content_view_daily.content_id DESC").
+		Limit(limit).
+		Scan(&ranked).Error
+	if err != nil {
+		code = "[REPOSITORY] GetPopularContents - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	contentIDs := []int64{}
+	for _, val := range ranked {
+		contentIDs = append(contentIDs, val.ContentID)
+	}
+
+	var modelContents []model.Content
+	err = v.db.Where("id IN ?", contentIDs).Preload("Category").Preload("User").Find(&modelContents).Error
+	if err != nil {
+		code = "[REPOSITORY] GetPopularContents - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	authors, err := contentAuthors(v.db, contentIDs)
+	if err != nil {
+		code = "[REPOSITORY] GetPopularContents - 3"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	contents := map[int64]model.Content{}
+	for _, val := range modelContents {
+		contents[val.ID] = val
+	}
+
+	resps := []entity.PopularContentEntity{}
+	for _, rank := range ranked {
+		val, ok := contents[rank.ContentID]
+		if !ok {
+			continue
+		}
+
+		updatedAt := val.CreatedAt
+		if val.UpdatedAt != nil {
+			updatedAt = *val.UpdatedAt
+		}
+
+		resp := entity.PopularContentEntity{
+			Content: entity.ContentEntity{
+				ID:            val.ID,
+				Title:         val.Title,
+				Slug:          val.Slug,
+				Excerpt:       val.Excerpt,
+				Image:         val.Image,
+				Tags:          strings.Split(val.Tags, ","),
+				Status:        val.Status,
+				WordCount:     val.WordCount,
+				ReadingTime:   val.ReadingTime,
+				CategoryID:    val.CategoryID,
+				UserID:        val.UserID,
+				CreatedAt:     val.CreatedAt,
+				UpdatedAt:     updatedAt,
+				ReactionCount: val.ReactionCount,
+				ViewCount:     val.ViewCount,
+				Category: entity.CategoryEntity{
+					ID:    val.Category.ID,
+					Title: val.Category.Title,
+					Slug:  val.Category.Slug,
+				},
+				User: entity.UserEntity{
+					ID:   val.User.ID,
+					Name: val.User.Name,
+				},
+				Authors: authors[val.ID],
+			},
+			Views: rank.Views,
+		}
+
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
+
+// GetContentViews implements ViewRepository.
+func (v *viewRepository) GetContentViews(ctx context.Context, contentID int64, since time.Time) (*entity.ViewStatsEntity, error) {
+	var modelContent model.Content
+
+	err = v.db.Select("id, view_count").Where("id = ?", contentID).First(&modelContent).Error
+	if err != nil {
+		code = "[REPOSITORY] GetContentViews - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	var modelViews []model.ContentViewDaily
+	err = v.db.Where("content_id = ? AND day >= ?", contentID, since).
+		Order("day ASC").
+		Find(&modelViews).Error
+	if err != nil {
+		code = "[REPOSITORY] GetContentViews - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resp := entity.ViewStatsEntity{
+		ContentID:  modelContent.ID,
+		TotalViews: modelContent.ViewCount,
+		Daily:      []entity.ViewDailyEntity{},
+	}
+
+	for _, val := range modelViews {
+		resp.Views += val.Views
+		resp.UniqueViews += val.UniqueViews
+		resp.Daily = append(resp.Daily, entity.ViewDailyEntity{
+			ContentID:   val.ContentID,
+			Day:         val.Day,
+			Views:       val.Views,
+			UniqueViews: val.UniqueViews,
+		})
+	}
+
+	return &resp, nil
+}
+
+func NewViewRepository(db *gorm.DB) ViewRepository {
+	return &viewRepository{
+		db: db,
+	}
+}
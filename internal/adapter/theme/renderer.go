@@ -0,0 +1,101 @@
+package theme
+
+import (
+	"blog/lib/permalink"
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+//go:embed templates/*.html
+var embedded embed.FS
+
+const (
+	PageHome     = "home"
+	PageCategory = "category"
+	PageTag      = "tag"
+	PagePost     = "post"
+	PageSearch   = "search"
+)
+
+var pages = []string{PageHome, PageCategory, PageTag, PagePost, PageSearch}
+
+// partials are parsed together with every page.
+var partials = []string{"layout", "list"}
+
+type Renderer interface {
+	Render(w io.Writer, page string, data interface{}) error
+}
+
+type renderer struct {
+	templates map[string]*template.Template
+}
+
+// Render implements Renderer. The page is rendered into a buffer first so a
+// template error never leaves a half written response.
+func (r *renderer) Render(w io.Writer, page string, data interface{}) error {
+	tmpl, ok := r.templates[page]
+	if !ok {
+		return fmt.Errorf("theme page %q not found", page)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "layout", data); err != nil {
+		return err
+	}
+
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// readTemplate prefers a file from the override directory and falls back to
+// the embedded default theme.
+func readTemplate(dir, name string) ([]byte, error) {
+	if dir != "" {
+		body, err := os.ReadFile(filepath.Join(dir, name+".html"))
+		if err == nil {
+			return body, nil
+		}
+
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	return embedded.ReadFile("templates/" + name + ".html")
+}
+
+// NewRenderer parses every page of the theme once. Templates found in dir
+// override the embedded ones file by file.
+func NewRenderer(dir string, baseUrl string) (Renderer, error) {
+	r := &renderer{templates: make(map[string]*template.Template)}
+	funcs := template.FuncMap{
+		"postUrl":     func(slug string) string { return permalink.Content(baseUrl, slug) },
+		"categoryUrl": func(slug string) string { return permalink.Category(baseUrl, slug) },
+		"tagUrl":      func(tag string) string { return permalink.Tag(baseUrl, tag) },
+	}
+
+	for _, page := range pages {
+		tmpl := template.New(page).Funcs(funcs)
+
+		names := append(append([]string{}, partials...), page)
+		for _, name := range names {
+			body, err := readTemplate(dir, name)
+			if err != nil {
+				return nil, fmt.Errorf("read theme template %s: %w", name, err)
+			}
+
+			if _, err = tmpl.New(name + ".html").Parse(string(body)); err != nil {
+				return nil, fmt.Errorf("parse theme template %s: %w", name, err)
+			}
+		}
+
+		r.templates[page] = tmpl
+	}
+
+	return r, nil
+}
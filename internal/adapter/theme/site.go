@@ -0,0 +1,482 @@
+package theme
+
+import (
+	"blog/config"
+	"blog/internal/core/domain/entity"
+	"blog/internal/core/service"
+	"blog/lib/conv"
+	"blog/lib/locale"
+	"blog/lib/permalink"
+	"blog/lib/preview"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+var ErrNotFound = errors.New("page not found")
+
+type SiteData struct {
+	Title       string
+	Description string
+	Url         string
+	Lang        string
+	Year        int
+}
+
+type MetaData struct {
+	Title       string
+	Description string
+	Canonical   string
+	Image       string
+	Type        string
+	NoIndex     bool
+	PublishedAt string
+	ModifiedAt  string
+	JsonLD      template.JS
+	Alternates  []AlternateData
+}
+
+// AlternateData is a language version of the page, rendered as hreflang.
+type AlternateData struct {
+	Lang string
+	Url  string
+}
+
+type TagData struct {
+	Name string
+	Url  string
+}
+
+type PostData struct {
+	Title         string
+	Url           string
+	Excerpt       string
+	Body          template.HTML
+	Image         string
+	Author        string
+	Authors       []string
+	CategoryTitle string
+	CategoryUrl   string
+	Tags          []TagData
+	PublishedAt   time.Time
+	UpdatedAt     time.Time
+}
+
+type PaginationData struct {
+	Page       int
+	TotalPages int
+	PrevUrl    string
+	NextUrl    string
+}
+
+type PageData struct {
+	Site       SiteData
+	Meta       MetaData
+	Categories []entity.CategoryEntity
+	Category   *entity.CategoryEntity
+	Heading    string
+	Query      string
+	Posts      []PostData
+	Post       *PostData
+	Pagination PaginationData
+}
+
+type Site interface {
+	Home(ctx context.Context, w io.Writer, page int) error
+	Category(ctx context.Context, w io.Writer, slug string, page int) error
+	Tag(ctx context.Context, w io.Writer, tag string, page int) error
+	Post(ctx context.Context, w io.Writer, slug string) error
+	Preview(ctx context.Context, w io.Writer, token string) error
+	Search(ctx context.Context, w io.Writer, query string, page int) error
+}
+
+type site struct {
+	contentService  service.ContentService
+	categoryService service.CategoryService
+	previewService  service.PreviewService
+	renderer        Renderer
+	cfg             *config.Config
+	locales         locale.Locales
+}
+
+// Home implements Site.
+func (s *site) Home(ctx context.Context, w io.Writer, page int) error {
+	data := s.newPage(ctx)
+	data.Meta.Canonical = pageUrl(permalink.Home(s.cfg.App.PublicUrl), page)
+
+	err := s.loadPosts(ctx, &data, entity.QueryString{Page: page}, permalink.Home(s.cfg.App.PublicUrl))
+	if err != nil {
+		return err
+	}
+
+	return s.renderer.Render(w, PageHome, data)
+}
+
+// Category implements Site.
+func (s *site) Category(ctx context.Context, w io.Writer, slug string, page int) error {
+	category, err := s.categoryService.GetCategoryBySlug(ctx, slug)
+	if err != nil {
+		return notFound(err)
+	}
+
+	categoryUrl := permalink.Category(s.cfg.App.PublicUrl, category.Slug)
+	data := s.newPage(ctx)
+	data.Category = category
+	data.Heading = category.Title
+	data.Meta.Title = firstNonEmpty(category.MetaTitle, category.Title) + " - " + s.cfg.App.SiteTitle
+	data.Meta.Description = firstNonEmpty(category.MetaDescription, category.Description, data.Meta.Description)
+	data.Meta.Image = category.Image
+	data.Meta.Canonical = pageUrl(categoryUrl, page)
+
+	err = s.loadPosts(ctx, &data, entity.QueryString{Page: page, CategoryID: category.ID}, categoryUrl)
+	if err != nil {
+		return err
+	}
+
+	return s.renderer.Render(w, PageCategory, data)
+}
+
+// Tag implements Site.
+func (s *site) Tag(ctx context.Context, w io.Writer, tag string, page int) error {
+	tagUrl := permalink.Tag(s.cfg.App.PublicUrl, tag)
+	data := s.newPage(ctx)
+	data.Heading = "#" + tag
+	data.Meta.Title = "#" + tag + " - " + s.cfg.App.SiteTitle
+	data.Meta.Canonical = pageUrl(tagUrl, page)
+
+	err := s.loadPosts(ctx, &data, entity.QueryString{Page: page, Tag: tag}, tagUrl)
+	if err != nil {
+		return err
+	}
+
+	if len(data.Posts) == 0 {
+		return ErrNotFound
+	}
+
+	return s.renderer.Render(w, PageTag, data)
+}
+
+// Post implements Site.
+func (s *site) Post(ctx context.Context, w io.Writer, slug string) error {
+	result, err := s.contentService.GetContentBySlug(ctx, slug)
+	if err != nil {
+		return notFound(err)
+	}
+
+	if result.Status != "PUBLISH" {
+		return ErrNotFound
+	}
+
+	return s.renderPost(ctx, w, *result, false)
+}
+
+// Preview implements Site. Expired, revoked and forged tokens all look like
+// a missing page.
+func (s *site) Preview(ctx context.Context, w io.Writer, token string) error {
+	result, err := s.previewService.GetContentByPreview(ctx, token)
+	if err != nil {
+		if errors.Is(err, service.ErrContentNotFound) || errors.Is(err, service.ErrPreviewInvalid) || errors.Is(err, preview.ErrExpiredToken) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return s.renderPost(ctx, w, *result, true)
+}
+
+// renderPost renders a content as a post page. A preview is kept out of
+// search engines and has no alternates, as its translations may not be
+// published yet.
+func (s *site) renderPost(ctx context.Context, w io.Writer, result entity.ContentEntity, isPreview bool) error {
+	var err error
+
+	post := s.toPost(result)
+	data := s.newPage(ctx)
+	data.Post = &post
+	data.Meta = MetaData{
+		Title:       firstNonEmpty(result.MetaTitle, result.Title+" - "+s.cfg.App.SiteTitle),
+		Description: firstNonEmpty(result.MetaDescription, result.Excerpt, data.Meta.Description),
+		Canonical:   firstNonEmpty(result.CanonicalUrl, post.Url),
+		Image:       firstNonEmpty(result.OgImage, result.Image),
+		Type:        "article",
+		NoIndex:     result.NoIndex || isPreview,
+		PublishedAt: result.CreatedAt.UTC().Format(time.RFC3339),
+		ModifiedAt:  result.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	// a custom JSON-LD document, validated on save, replaces the generated one
+	if result.JsonLD != "" {
+		data.Meta.JsonLD, err = compactJsonLD(result.JsonLD)
+	} else {
+		data.Meta.JsonLD, err = s.blogPostingJsonLD(post, data.Meta.Description)
+	}
+	if err != nil {
+		return err
+	}
+
+	data.Site.Lang = result.Locale
+	for _, translation := range result.Translations {
+		if translation.Status == "PUBLISH" && !isPreview {
+			data.Meta.Alternates = append(data.Meta.Alternates, AlternateData{Lang: translation.Locale, Url: translation.Url})
+		}
+	}
+	if len(data.Meta.Alternates) > 0 {
+		data.Meta.Alternates = append(data.Meta.Alternates, AlternateData{Lang: result.Locale, Url: post.Url})
+	}
+
+	return s.renderer.Render(w, PagePost, data)
+}
+
+// Search implements Site.
+func (s *site) Search(ctx context.Context, w io.Writer, query string, page int) error {
+	searchUrl := strings.TrimRight(s.cfg.App.PublicUrl, "/") + "/search?q=" + url.QueryEscape(query)
+	data := s.newPage(ctx)
+	data.Query = query
+	data.Heading = "Search"
+	data.Meta.Title = "Search - " + s.cfg.App.SiteTitle
+	data.Meta.Canonical = searchUrl
+	data.Meta.NoIndex = true
+
+	if query != "" {
+		data.Heading = fmt.Sprintf("Search results for %q", query)
+
+		err := s.loadPosts(ctx, &data, entity.QueryString{Page: page, Search: query}, searchUrl)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.renderer.Render(w, PageSearch, data)
+}
+
+func (s *site) newPage(ctx context.Context) PageData {
+	categories, err := s.categoryService.GetCategoriesByLocale(ctx, s.locales.Default())
+	if err != nil {
+		code := "[THEME] newPage - 1"
+		log.Errorw(code, err)
+	}
+
+	return PageData{
+		Site: SiteData{
+			Title:       s.cfg.App.SiteTitle,
+			Description: s.cfg.App.SiteDescription,
+			Url:         strings.TrimRight(s.cfg.App.PublicUrl, "/"),
+			Lang:        s.locales.Default(),
+			Year:        time.Now().Year(),
+		},
+		Meta: MetaData{
+			Title:       s.cfg.App.SiteTitle,
+			Description: s.cfg.App.SiteDescription,
+			Type:        "website",
+		},
+		Categories: categories,
+	}
+}
+
+func (s *site) loadPosts(ctx context.Context, data *PageData, query entity.QueryString, baseUrl string) error {
+	if query.Page < 1 {
+		return ErrNotFound
+	}
+
+	query.Limit = s.perPage()
+	query.OrderBy = "created_at"
+	query.OrderType = "desc"
+	query.Status = "PUBLISH"
+	// listings show the default language, translations are linked from each post
+	query.Locale = s.locales.Default()
+	query.FallbackLocale = s.locales.Default()
+
+	results, _, totalPages, err := s.contentService.GetContents(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if query.Page > 1 && query.Page > int(totalPages) {
+		return ErrNotFound
+	}
+
+	for _, result := range results {
+		data.Posts = append(data.Posts, s.toPost(result))
+	}
+
+	data.Pagination = PaginationData{
+		Page:       query.Page,
+		TotalPages: max(int(totalPages), 1),
+	}
+
+	if query.Page > 1 {
+		data.Pagination.PrevUrl = pageUrl(baseUrl, query.Page-1)
+	}
+
+	if query.Page < int(totalPages) {
+		data.Pagination.NextUrl = pageUrl(baseUrl, query.Page+1)
+	}
+
+	return nil
+}
+
+func (s *site) perPage() int {
+	if s.cfg.Theme.PerPage > 0 {
+		return s.cfg.Theme.PerPage
+	}
+
+	return 10
+}
+
+func (s *site) toPost(result entity.ContentEntity) PostData {
+	post := PostData{
+		Title:         result.Title,
+		Url:           permalink.Content(s.cfg.App.PublicUrl, result.Slug),
+		Excerpt:       result.Excerpt,
+		Body:          template.HTML(conv.SanitizeHTML(result.Description)),
+		Image:         result.Image,
+		Authors:       authorNames(result),
+		CategoryTitle: result.Category.Title,
+		PublishedAt:   result.CreatedAt,
+		UpdatedAt:     result.UpdatedAt,
+	}
+
+	post.Author = strings.Join(post.Authors, ", ")
+
+	if result.Category.Slug != "" {
+		post.CategoryUrl = permalink.Category(s.cfg.App.PublicUrl, result.Category.Slug)
+	}
+
+	for _, tag := range result.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			post.Tags = append(post.Tags, TagData{Name: tag, Url: permalink.Tag(s.cfg.App.PublicUrl, tag)})
+		}
+	}
+
+	return post
+}
+
+func (s *site) blogPostingJsonLD(post PostData, description string) (template.JS, error) {
+	var keywords []string
+	for _, tag := range post.Tags {
+		keywords = append(keywords, tag.Name)
+	}
+
+	authors := []map[string]string{}
+	for _, name := range post.Authors {
+		authors = append(authors, map[string]string{"@type": "Person", "name": name})
+	}
+
+	doc := map[string]interface{}{
+		"@context":         "https://schema.org",
+		"@type":            "BlogPosting",
+		"headline":         post.Title,
+		"description":      description,
+		"url":              post.Url,
+		"datePublished":    post.PublishedAt.UTC().Format(time.RFC3339),
+		"dateModified":     post.UpdatedAt.UTC().Format(time.RFC3339),
+		"mainEntityOfPage": map[string]string{"@type": "WebPage", "@id": post.Url},
+		"author":           authors,
+		"publisher":        map[string]string{"@type": "Organization", "name": s.cfg.App.SiteTitle},
+	}
+
+	if post.Image != "" {
+		doc["image"] = post.Image
+	}
+
+	if post.CategoryTitle != "" {
+		doc["articleSection"] = post.CategoryTitle
+	}
+
+	if len(keywords) > 0 {
+		doc["keywords"] = strings.Join(keywords, ", ")
+	}
+
+	// json.Marshal escapes <, > and &, so the output is safe inside a script tag
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return template.JS(body), nil
+}
+
+// compactJsonLD re-encodes a stored JSON-LD document so that, like the
+// generated one, it has <, > and & escaped and is safe inside a script tag.
+func compactJsonLD(doc string) (template.JS, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(doc), &value); err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return template.JS(body), nil
+}
+
+// pageUrl returns the url of a paginated listing, page 1 being the listing itself.
+func pageUrl(baseUrl string, page int) string {
+	if page <= 1 {
+		return baseUrl
+	}
+
+	if strings.Contains(baseUrl, "?") {
+		return fmt.Sprintf("%s&page=%d", baseUrl, page)
+	}
+
+	return fmt.Sprintf("%s/page/%d", strings.TrimRight(baseUrl, "/"), page)
+}
+
+func notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+
+	return err
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// authorNames lists who a content is credited to, in credit order.
+func authorNames(result entity.ContentEntity) []string {
+	if len(result.Authors) == 0 {
+		return []string{result.User.Name}
+	}
+
+	names := []string{}
+	for _, val := range result.Authors {
+		if val.User.DisplayName != "" {
+			names = append(names, val.User.DisplayName)
+		} else {
+			names = append(names, val.User.Name)
+		}
+	}
+
+	return names
+}
+
+func NewSite(contentService service.ContentService, categoryService service.CategoryService, previewService service.PreviewService, renderer Renderer, cfg *config.Config, locales locale.Locales) Site {
+	return &site{
+		contentService:  contentService,
+		categoryService: categoryService,
+		previewService:  previewService,
+		renderer:        renderer,
+		cfg:             cfg,
+		locales:         locales,
+	}
+}
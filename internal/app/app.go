@@ -5,10 +5,15 @@ import (
 	"blog/internal/adapter/cloudflare"
 	"blog/internal/adapter/handler"
 	"blog/internal/adapter/repository"
+	"blog/internal/adapter/theme"
 	"blog/internal/core/service"
 	"blog/lib/auth"
+	"blog/lib/cache"
+	"blog/lib/locale"
 	"blog/lib/middleware"
 	"blog/lib/pagination"
+	"blog/lib/preview"
+	"blog/lib/visitor"
 	"context"
 	"log"
 	"os"
@@ -29,13 +34,13 @@ func RunServer() {
 
 	db, err := cfg.ConnectionPostgres()
 	if err != nil {
-		log.Fatal("error connecting to database: %v", err)
+		log.Fatalf("error connecting to database: %v", err)
 		return
 	}
 
 	err = os.MkdirAll("./temp/content", 0755)
 	if err != nil {
-		log.Fatal("error creating directory: %v", err)
+		log.Fatalf("error creating directory: %v", err)
 		return
 	}
 
@@ -46,29 +51,70 @@ func RunServer() {
 
 	jwt := auth.NewJwt(cfg)
 	middlewareAuth := middleware.NewMiddleware(cfg)
+	visitorID := visitor.NewVisitor(cfg)
+	previewSigner := preview.NewSigner(cfg)
 
 	_ = pagination.NewPagination()
+	appCache := cache.NewCache()
+	locales := locale.NewLocales(cfg)
 
 	// Repository
 	authRepository := repository.NewAuthRepository(db.DB)
 	categoryRepository := repository.NewCategoryRepository(db.DB)
 	contentRepository := repository.NewContentRepository(db.DB)
 	userRepository := repository.NewUserRepository(db.DB)
+	sitemapRepository := repository.NewSitemapRepository(db.DB)
+	commentRepository := repository.NewCommentRepository(db.DB)
+	spamRepository := repository.NewSpamRepository(db.DB)
+	reactionRepository := repository.NewReactionRepository(db.DB)
+	viewRepository := repository.NewViewRepository(db.DB)
+	dashboardRepository := repository.NewDashboardRepository(db.DB)
+	seriesRepository := repository.NewSeriesRepository(db.DB)
+	translationRepository := repository.NewTranslationRepository(db.DB)
+	previewRepository := repository.NewPreviewRepository(db.DB)
+	lockRepository := repository.NewLockRepository(db.DB)
+	templateRepository := repository.NewTemplateRepository(db.DB)
 
 	// Service
 	authService := service.NewAuthService(authRepository, cfg, jwt)
-	categoryService := service.NewCategoryService(categoryRepository)
-	contentService := service.NewContentService(contentRepository, cfg, r2Adapter)
-	userService := service.NewUserService(userRepository)
+	categoryService := service.NewCategoryService(categoryRepository, appCache, cfg, locales)
+	contentService := service.NewContentService(contentRepository, cfg, r2Adapter, appCache, locales, templateRepository)
+	feedService := service.NewFeedService(contentRepository, categoryRepository, cfg)
+	userService := service.NewUserService(userRepository, r2Adapter)
+	sitemapService := service.NewSitemapService(sitemapRepository, appCache, cfg)
+	spamService := service.NewSpamService(spamRepository, commentRepository, appCache, cfg)
+	commentService := service.NewCommentService(commentRepository, contentRepository, spamService)
+	reactionService := service.NewReactionService(reactionRepository, contentRepository)
+	viewService := service.NewViewService(viewRepository, appCache)
+	dashboardService := service.NewDashboardService(dashboardRepository, appCache)
+	seriesService := service.NewSeriesService(seriesRepository, contentRepository)
+	translationService := service.NewTranslationService(translationRepository, locales)
+	previewService := service.NewPreviewService(previewRepository, contentService, previewSigner, cfg)
+	lockService := service.NewLockService(lockRepository, contentService)
+	templateService := service.NewTemplateService(templateRepository)
 
 	// Handler
 	authHandler := handler.NewAuthHandler(authService)
 	categoryHandler := handler.NewCategoryHandler(categoryService)
-	contentHandler := handler.NewContentHandler(contentService)
-	userHandler := handler.NewUserHandler(userService)
+	contentHandler := handler.NewContentHandler(contentService, viewService, visitorID)
+	feedHandler := handler.NewFeedHandler(feedService)
+	sitemapHandler := handler.NewSitemapHandler(sitemapService)
+	userHandler := handler.NewUserHandler(userService, contentService)
+	commentHandler := handler.NewCommentHandler(commentService)
+	spamHandler := handler.NewSpamHandler(spamService)
+	reactionHandler := handler.NewReactionHandler(reactionService, visitorID)
+	dashboardHandler := handler.NewDashboardHandler(dashboardService)
+	seriesHandler := handler.NewSeriesHandler(seriesService)
+	translationHandler := handler.NewTranslationHandler(translationService)
+	previewHandler := handler.NewPreviewHandler(previewService)
+	lockHandler := handler.NewLockHandler(lockService)
+	templateHandler := handler.NewTemplateHandler(templateService)
 
 	app := fiber.New()
-	app.Use(cors.New())
+	// the admin reads ETag to send it back as If-Match on edits
+	app.Use(cors.New(cors.Config{
+		ExposeHeaders: fiber.HeaderETag,
+	}))
 	app.Use(recover.New())
 	app.Use(logger.New(logger.Config{
 		Format: "[${time}] ${ip} ${status} - ${latency} ${method} ${path}\n",
@@ -85,6 +131,15 @@ func RunServer() {
 		app.Use(swagger.New(cfg))
 	}
 
+	// Feed route
+	app.Get("/feed.xml", feedHandler.RSS)
+	app.Get("/atom.xml", feedHandler.Atom)
+	app.Get("/feed.json", feedHandler.JSONFeed)
+
+	// Sitemap route
+	app.Get("/sitemap.xml", sitemapHandler.GetSitemapIndex)
+	app.Get("/sitemap-:type-:page.xml", sitemapHandler.GetSitemap)
+
 	api := app.Group("/api")
 
 	api.Post("/login", authHandler.Login)
@@ -92,6 +147,9 @@ func RunServer() {
 	adminApp := api.Group("/admin")
 	adminApp.Use(middlewareAuth.CheckToken())
 
+	// Dashboard route
+	adminApp.Get("/dashboard", dashboardHandler.GetDashboard)
+
 	// Category route
 	categoryApp := adminApp.Group("/categories")
 	categoryApp.Get("/", categoryHandler.GetCategories)
@@ -104,21 +162,130 @@ func RunServer() {
 	contentApp := adminApp.Group("/contents")
 	contentApp.Get("/", contentHandler.GetContents)
 	contentApp.Post("/create", contentHandler.CreateContent)
+	contentApp.Put("/featured/order", contentHandler.ReorderFeatured)
 	contentApp.Get("/:contentId", contentHandler.GetContentByID)
 	contentApp.Put("/:contentId", contentHandler.EditContentByID)
 	contentApp.Delete("/:contentId", contentHandler.DeleteContent)
+	contentApp.Get("/:contentId/views", contentHandler.GetContentViews)
+	contentApp.Get("/:contentId/seo", contentHandler.AuditContent)
+	contentApp.Put("/:contentId/featured", contentHandler.SetFeatured)
+	contentApp.Put("/:contentId/pin", contentHandler.SetPinned)
+	contentApp.Put("/:contentId/authors", contentHandler.SetContentAuthors)
+	contentApp.Get("/:contentId/previews", previewHandler.GetPreviews)
+	contentApp.Post("/:contentId/previews", previewHandler.CreatePreview)
+	contentApp.Delete("/:contentId/previews/:previewId", previewHandler.RevokePreview)
+	contentApp.Post("/:contentId/lock", lockHandler.AcquireLock)
+	contentApp.Put("/:contentId/lock", lockHandler.Heartbeat)
+	contentApp.Delete("/:contentId/lock", lockHandler.ReleaseLock)
+	contentApp.Put("/:contentId/draft", contentHandler.SaveDraft)
+	contentApp.Delete("/:contentId/draft", contentHandler.DiscardDraft)
+	contentApp.Post("/:contentId/draft/publish", contentHandler.PublishDraft)
+	contentApp.Post("/:contentId/clone", contentHandler.CloneContent)
 	contentApp.Post("/upload-image", contentHandler.UploadImageR2)
+	contentApp.Post("/bulk", contentHandler.BulkContents)
+
+	// Template route
+	templateApp := adminApp.Group("/templates")
+	templateApp.Get("/", templateHandler.GetTemplates)
+	templateApp.Post("/", templateHandler.CreateTemplate)
+	templateApp.Get("/:templateId", templateHandler.GetTemplateByID)
+	templateApp.Put("/:templateId", templateHandler.EditTemplateByID)
+	templateApp.Delete("/:templateId", templateHandler.DeleteTemplate)
+
+	// Series route
+	seriesApp := adminApp.Group("/series")
+	seriesApp.Get("/", seriesHandler.GetSeries)
+	seriesApp.Post("/", seriesHandler.CreateSeries)
+	seriesApp.Get("/:seriesId", seriesHandler.GetSeriesByID)
+	seriesApp.Put("/:seriesId", seriesHandler.EditSeriesByID)
+	seriesApp.Delete("/:seriesId", seriesHandler.DeleteSeries)
+	seriesApp.Put("/:seriesId/contents", seriesHandler.SetSeriesContents)
+
+	// Translation route
+	adminApp.Get("/translations/missing", translationHandler.GetMissingTranslations)
+
+	// Comment route
+	commentApp := adminApp.Group("/comments")
+	commentApp.Get("/", commentHandler.GetComments)
+	commentApp.Post("/bulk", commentHandler.BulkComments)
+	commentApp.Get("/:commentId", commentHandler.GetCommentByID)
+	commentApp.Put("/:commentId", commentHandler.EditCommentByID)
+	commentApp.Put("/:commentId/approve", commentHandler.ApproveComment)
+	commentApp.Put("/:commentId/reject", commentHandler.RejectComment)
+	commentApp.Put("/:commentId/spam", commentHandler.SpamComment)
+	commentApp.Delete("/:commentId", commentHandler.DeleteComment)
+
+	// Spam route
+	spamApp := adminApp.Group("/spam")
+	spamApp.Get("/blocklist", spamHandler.GetBlocklist)
+	spamApp.Post("/blocklist", spamHandler.CreateBlocklist)
+	spamApp.Delete("/blocklist/:blocklistId", spamHandler.DeleteBlocklist)
 
 	// User route
 	userApp := adminApp.Group("/users")
 	userApp.Get("/profile", userHandler.GetUserById)
 	userApp.Put("/update-password", userHandler.UpdatePassword)
+	userApp.Put("/profile", userHandler.UpdateProfile)
+	userApp.Post("/avatar", userHandler.UploadAvatar)
 
 	// FE
+	commentRateLimit := cfg.Comment.RateLimitMax
+	if commentRateLimit <= 0 {
+		commentRateLimit = 5
+	}
+
+	commentRateWindow := time.Duration(cfg.Comment.RateLimitWindow) * time.Second
+	if commentRateWindow <= 0 {
+		commentRateWindow = time.Minute
+	}
+
 	feApp := api.Group("/fe")
 	feApp.Get("/categories", categoryHandler.GetCategoryFE)
+	feApp.Get("/categories/:slug", categoryHandler.GetCategoryBySlugFE)
+	feApp.Get("/series", seriesHandler.GetSeriesFE)
+	feApp.Get("/series/:slug", seriesHandler.GetSeriesBySlugFE)
+	feApp.Get("/authors/:slug", userHandler.GetAuthorBySlugFE)
 	feApp.Get("/contents", contentHandler.GetContentWithQuery)
+	feApp.Get("/contents/popular", contentHandler.GetPopularContents)
+	feApp.Get("/contents/featured", contentHandler.GetFeaturedContents)
 	feApp.Get("/contents/:contentId", contentHandler.GetContentDetail)
+	feApp.Get("/contents/:contentId/related", contentHandler.GetRelatedContents)
+	feApp.Get("/preview/:token", previewHandler.GetPreviewFE)
+	feApp.Get("/comments/token", spamHandler.GetTokenFE)
+	feApp.Get("/contents/:contentId/comments", commentHandler.GetCommentsFE)
+	feApp.Post("/contents/:contentId/comments", middlewareAuth.RateLimit(commentRateLimit, commentRateWindow), commentHandler.CreateCommentFE)
+	feApp.Get("/contents/:contentId/reactions", reactionHandler.GetReactionsFE)
+	feApp.Post("/contents/:contentId/reactions", middlewareAuth.RateLimit(30, time.Minute), reactionHandler.AddReactionFE)
+	feApp.Delete("/contents/:contentId/reactions/:reaction", middlewareAuth.RateLimit(30, time.Minute), reactionHandler.RemoveReactionFE)
+
+	// Theme
+	if cfg.Theme.Enabled {
+		renderer, err := theme.NewRenderer(cfg.Theme.Dir, cfg.App.PublicUrl)
+		if err != nil {
+			log.Fatalf("error loading theme: %v", err)
+			return
+		}
+
+		themeHandler := handler.NewThemeHandler(theme.NewSite(contentService, categoryService, previewService, renderer, cfg, locales))
+
+		app.Get("/", themeHandler.Home)
+		app.Get("/page/:page", themeHandler.Home)
+		app.Get("/categories/:slug", themeHandler.Category)
+		app.Get("/categories/:slug/page/:page", themeHandler.Category)
+		app.Get("/tags/:tag", themeHandler.Tag)
+		app.Get("/tags/:tag/page/:page", themeHandler.Tag)
+		app.Get("/posts/:slug", themeHandler.Post)
+		app.Get("/preview/:token", themeHandler.Preview)
+		app.Get("/search", themeHandler.Search)
+	}
+
+	flushInterval := time.Duration(cfg.View.FlushInterval) * time.Second
+	if flushInterval <= 0 {
+		flushInterval = time.Minute
+	}
+
+	viewCtx, stopViews := context.WithCancel(context.Background())
+	go viewService.Run(viewCtx, flushInterval)
 
 	go func() {
 		if cfg.App.AppPort == "" {
@@ -127,7 +294,7 @@ func RunServer() {
 
 		err := app.Listen(":" + cfg.App.AppPort)
 		if err != nil {
-			log.Fatal("error starting server: %v", err)
+			log.Fatalf("error starting server: %v", err)
 		}
 	}()
 
@@ -143,4 +310,9 @@ func RunServer() {
 	defer cancel()
 
 	app.ShutdownWithContext(ctx)
+
+	stopViews()
+	if err := viewService.Flush(ctx); err != nil {
+		log.Printf("error flushing page views: %v", err)
+	}
 }
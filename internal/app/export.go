@@ -0,0 +1,69 @@
+package app
+
+import (
+	"blog/config"
+	"blog/internal/adapter/cloudflare"
+	"blog/internal/adapter/export"
+	"blog/internal/adapter/repository"
+	"blog/internal/adapter/theme"
+	"blog/internal/core/service"
+	"blog/lib/cache"
+	"blog/lib/locale"
+	"blog/lib/preview"
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func RunExport(opts export.Options) {
+	cfg := config.NewConfig()
+
+	db, err := cfg.ConnectionPostgres()
+	if err != nil {
+		log.Fatalf("error connecting to database: %v", err)
+		return
+	}
+
+	var r2Adapter cloudflare.CloudFlareR2Adapter
+	if opts.Push {
+		cdfR2 := cfg.LoadAwsConfig()
+		s3Client := s3.NewFromConfig(cdfR2)
+		r2Adapter = cloudflare.NewCloudflareR2Adapter(s3Client, cfg)
+	}
+
+	appCache := cache.NewCache()
+	locales := locale.NewLocales(cfg)
+
+	// Repository
+	categoryRepository := repository.NewCategoryRepository(db.DB)
+	contentRepository := repository.NewContentRepository(db.DB)
+	sitemapRepository := repository.NewSitemapRepository(db.DB)
+	previewRepository := repository.NewPreviewRepository(db.DB)
+	templateRepository := repository.NewTemplateRepository(db.DB)
+
+	// Service
+	categoryService := service.NewCategoryService(categoryRepository, appCache, cfg, locales)
+	contentService := service.NewContentService(contentRepository, cfg, r2Adapter, appCache, locales, templateRepository)
+	feedService := service.NewFeedService(contentRepository, categoryRepository, cfg)
+	sitemapService := service.NewSitemapService(sitemapRepository, appCache, cfg)
+	previewService := service.NewPreviewService(previewRepository, contentService, preview.NewSigner(cfg), cfg)
+
+	renderer, err := theme.NewRenderer(cfg.Theme.Dir, cfg.App.PublicUrl)
+	if err != nil {
+		log.Fatalf("error loading theme: %v", err)
+		return
+	}
+
+	site := theme.NewSite(contentService, categoryService, previewService, renderer, cfg, locales)
+	exporter := export.NewExporter(site, feedService, sitemapService, categoryService, sitemapRepository, r2Adapter, cfg)
+
+	result, err := exporter.Export(context.Background(), opts)
+	if err != nil {
+		log.Fatalf("error exporting site: %v", err)
+		return
+	}
+
+	log.Printf("Export finished: %d pages rendered, %d files written, %d files removed, %d files pushed",
+		result.Rendered, result.Written, result.Removed, result.Pushed)
+}
@@ -0,0 +1,36 @@
+package entity
+
+const (
+	BulkActionStatus     = "status"
+	BulkActionCategory   = "category"
+	BulkActionAddTags    = "add_tags"
+	BulkActionRemoveTags = "remove_tags"
+	BulkActionDelete     = "delete"
+	BulkActionRestore    = "restore"
+)
+
+// BulkContentEntity applies one action to many contents. Status, CategoryID
+// and Tags are read depending on Action.
+type BulkContentEntity struct {
+	IDs        []int64
+	Action     string
+	Status     string
+	CategoryID int64
+	Tags       []string
+	DryRun     bool
+}
+
+// BulkResultEntity is the outcome for one content; Error is empty when the
+// action applies to it.
+type BulkResultEntity struct {
+	ID    int64
+	Error string
+}
+
+// BulkReportEntity tells whether the batch was committed. It is not when
+// it was a dry run or any item failed.
+type BulkReportEntity struct {
+	Applied bool
+	DryRun  bool
+	Results []BulkResultEntity
+}
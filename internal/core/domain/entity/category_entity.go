@@ -1,8 +1,19 @@
 package entity
 
 type CategoryEntity struct {
-	ID    int64
-	Title string
-	Slug  string
-	User  UserEntity
+	ID                 int64
+	Title              string
+	Slug               string
+	Description        string
+	Image              string
+	MetaTitle          string
+	MetaDescription    string
+	DisplayOrder       int
+	Locale             string
+	TranslationGroupID int64
+	TranslationOf      int64
+	Translations       []TranslationEntity
+	Version            int
+	PostCount          int64
+	User               UserEntity
 }
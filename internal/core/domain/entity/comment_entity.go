@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+const (
+	CommentStatusPending  = "PENDING"
+	CommentStatusApproved = "APPROVED"
+	CommentStatusRejected = "REJECTED"
+	CommentStatusSpam     = "SPAM"
+)
+
+type CommentEntity struct {
+	ID          int64
+	ContentID   int64
+	ParentID    int64
+	Name        string
+	Email       string
+	Body        string
+	Status      string
+	IPAddress   string
+	UserAgent   string
+	Honeypot    string
+	Token       string
+	Fingerprint string
+	SpamScore   float64
+	SpamReasons []string
+	CreatedAt   time.Time
+	Content     ContentEntity
+	Replies     []CommentEntity
+}
+
+type CommentQuery struct {
+	Limit     int
+	Page      int
+	Status    string
+	ContentID int64
+	Search    string
+}
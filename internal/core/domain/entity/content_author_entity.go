@@ -0,0 +1,20 @@
+package entity
+
+import "errors"
+
+const (
+	AuthorRoleAuthor      = "author"
+	AuthorRoleEditor      = "editor"
+	AuthorRoleContributor = "contributor"
+)
+
+// ErrAuthorNotFound is returned when a credited user does not exist.
+var ErrAuthorNotFound = errors.New("author user not found")
+
+// ContentAuthorEntity credits a user on a content. Position orders the
+// credits, starting at 1 with the primary author.
+type ContentAuthorEntity struct {
+	User     UserEntity
+	Role     string
+	Position int
+}
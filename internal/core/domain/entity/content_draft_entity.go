@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// ContentDraftEntity is the working copy of a content. Autosaves land here
+// and leave the content itself untouched until the draft is published.
+// BaseVersion is the content version the draft was started from.
+type ContentDraftEntity struct {
+	ContentID       int64
+	BaseVersion     int
+	Title           string
+	Excerpt         string
+	Description     string
+	Image           string
+	Tags            []string
+	CategoryID      int64
+	MetaTitle       string
+	MetaDescription string
+	CanonicalUrl    string
+	OgImage         string
+	NoIndex         bool
+	JsonLD          string
+	SavedAt         time.Time
+	User            UserEntity
+}
+
+// PublishDraftEntity asks for the draft of ContentID to be published over
+// Version. A published content keeps its slug unless RegenerateSlug is set,
+// and a draft started from an older version is only published with Rebase.
+type PublishDraftEntity struct {
+	ContentID      int64
+	Version        int
+	RegenerateSlug bool
+	Rebase         bool
+}
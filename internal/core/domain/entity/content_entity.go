@@ -3,18 +3,67 @@ package entity
 import "time"
 
 type ContentEntity struct {
-	ID          int64
-	Title       string
-	Excerpt     string
-	Description string
-	Image       string
-	Tags        []string
-	Status      string
-	CategoryID  int64
-	UserID      int64
-	CreatedAt   time.Time
-	Category    CategoryEntity
-	User        UserEntity
+	ID                 int64
+	Title              string
+	Slug               string
+	Excerpt            string
+	Description        string
+	Image              string
+	Tags               []string
+	Status             string
+	Locale             string
+	TranslationGroupID int64
+	TranslationOf      int64
+	TemplateID         int64
+	RegenerateSlug     bool
+	Translations       []TranslationEntity
+	WordCount          int
+	ReadingTime        int
+	Toc                []HeadingEntity
+	MetaTitle          string
+	MetaDescription    string
+	CanonicalUrl       string
+	OgImage            string
+	NoIndex            bool
+	JsonLD             string
+	Version            int
+	CategoryID         int64
+	UserID             int64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	FeaturedPosition   int
+	FeaturedUntil      *time.Time
+	Pinned             bool
+	DeletedAt          *time.Time
+	CommentCount       int64
+	ReactionCount      int64
+	ViewCount          int64
+	Reactions          map[string]int64
+	Category           CategoryEntity
+	User               UserEntity
+	Authors            []ContentAuthorEntity
+	Series             *SeriesNavEntity
+	Lock               *ContentLockEntity
+	Draft              *ContentDraftEntity
+}
+
+// HeadingEntity is one entry of a content's table of contents.
+type HeadingEntity struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	ID    string `json:"id"`
+}
+
+type SeoIssueEntity struct {
+	Field    string
+	Severity string
+	Message  string
+}
+
+type SeoReportEntity struct {
+	ContentID int64
+	Score     int
+	Issues    []SeoIssueEntity
 }
 
 type QueryString struct {
@@ -24,5 +73,27 @@ type QueryString struct {
 	OrderType  string
 	Search     string
 	CategoryID int64
-	Status     string
+	// AuthorID matches contents the user is credited on, in any role.
+	AuthorID int64
+	Tag      string
+	Status   string
+	Featured bool
+	// Deleted lists deleted contents instead of live ones.
+	Deleted bool
+	// Locale keeps one content per translation group, in Locale when it
+	// exists and in FallbackLocale otherwise.
+	Locale         string
+	FallbackLocale string
+}
+
+type FeatureEntity struct {
+	ContentID int64
+	Featured  bool
+	Position  int
+	Until     *time.Time
+}
+
+type RelatedContentEntity struct {
+	Content ContentEntity
+	Score   float64
 }
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// ContentLockEntity is an advisory lock telling other editors who is
+// working on a content. It lapses at ExpiresAt unless renewed.
+type ContentLockEntity struct {
+	ContentID  int64
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+	User       UserEntity
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// ContentTemplateEntity is a starting point for new contents. TitlePattern
+// may hold {date}, {year}, {month}, {week} and {day} placeholders, and
+// Description is the body skeleton.
+type ContentTemplateEntity struct {
+	ID           int64
+	Name         string
+	TitlePattern string
+	Description  string
+	Tags         []string
+	CategoryID   int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Category     CategoryEntity
+	User         UserEntity
+}
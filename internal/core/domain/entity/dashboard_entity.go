@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+type DashboardQuery struct {
+	StaleDays   int
+	Interval    string
+	Periods     int
+	RecentLimit int
+}
+
+type StatusCountEntity struct {
+	Status string
+	Total  int64
+}
+
+type CategoryCountEntity struct {
+	CategoryID int64
+	Title      string
+	Total      int64
+	Published  int64
+}
+
+type AuthorCountEntity struct {
+	UserID    int64
+	Name      string
+	Total     int64
+	Published int64
+}
+
+type ActivityEntity struct {
+	Type      string
+	ContentID int64
+	Title     string
+	Actor     string
+	Status    string
+	At        time.Time
+}
+
+type CadenceEntity struct {
+	Period time.Time
+	Total  int64
+}
+
+type DashboardEntity struct {
+	TotalContents   int64
+	PendingComments int64
+	StatusCounts    []StatusCountEntity
+	Categories      []CategoryCountEntity
+	Authors         []AuthorCountEntity
+	RecentActivity  []ActivityEntity
+	StaleDrafts     []ContentEntity
+	Cadence         []CadenceEntity
+	GeneratedAt     time.Time
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+type FeedEntity struct {
+	Title       string
+	Description string
+	Link        string
+	FeedLink    string
+	UpdatedAt   time.Time
+	Items       []FeedItemEntity
+}
+
+type FeedItemEntity struct {
+	ID          int64
+	Title       string
+	Link        string
+	Summary     string
+	Content     string
+	Image       string
+	Authors     []string
+	Category    string
+	Tags        []string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+type FeedQuery struct {
+	CategorySlug string
+	Tag          string
+	FullContent  bool
+	Limit        int
+}
@@ -1,6 +1,7 @@
 package entity
 
 type FileUploadEntity struct {
-	Name string
-	Path string
+	Name        string
+	Path        string
+	ContentType string
 }
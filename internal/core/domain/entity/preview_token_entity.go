@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// PreviewTokenEntity is a revocable link to an unpublished content. Token
+// and Url are only known when the link is created.
+type PreviewTokenEntity struct {
+	ID        int64
+	ContentID int64
+	Token     string
+	Url       string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+	User      UserEntity
+}
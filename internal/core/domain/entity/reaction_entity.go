@@ -0,0 +1,17 @@
+package entity
+
+// ReactionTypes is the fixed set of reactions readers can leave on a post.
+var ReactionTypes = []string{"like", "love", "laugh", "wow", "sad", "clap"}
+
+type ReactionEntity struct {
+	ContentID   int64
+	Reaction    string
+	VisitorHash string
+}
+
+type ReactionSummaryEntity struct {
+	ContentID int64
+	Counts    map[string]int64
+	Total     int64
+	Mine      []string
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+type SeriesEntity struct {
+	ID          int64
+	Title       string
+	Slug        string
+	Description string
+	UserID      int64
+	PartCount   int64
+	CreatedAt   time.Time
+	User        UserEntity
+	Parts       []ContentEntity
+}
+
+// SeriesNavEntity places a content within its series.
+type SeriesNavEntity struct {
+	ID       int64
+	Title    string
+	Slug     string
+	Part     int
+	Total    int
+	Previous *ContentEntity
+	Next     *ContentEntity
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type SitemapItemEntity struct {
+	Slug      string
+	Image     string
+	UpdatedAt time.Time
+}
+
+type SitemapEntity struct {
+	Kind    string
+	Page    int
+	LastMod time.Time
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+const (
+	SpamBlocklistWord   = "WORD"
+	SpamBlocklistDomain = "DOMAIN"
+
+	SpamSourceManual  = "MANUAL"
+	SpamSourceTrained = "TRAINED"
+)
+
+type SpamBlocklistEntity struct {
+	ID        int64
+	Kind      string
+	Value     string
+	Source    string
+	CreatedAt time.Time
+}
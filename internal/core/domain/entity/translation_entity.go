@@ -0,0 +1,27 @@
+package entity
+
+// TranslationEntity is another language version of a content or category.
+type TranslationEntity struct {
+	ID     int64
+	Locale string
+	Title  string
+	Slug   string
+	Status string
+	Url    string
+}
+
+// TranslationGroupEntity lists the locales a content or category exists in.
+type TranslationGroupEntity struct {
+	GroupID int64
+	ID      int64
+	Title   string
+	Locales []string
+	Missing []string
+}
+
+// MissingTranslationsEntity holds the groups lacking at least one supported
+// locale.
+type MissingTranslationsEntity struct {
+	Contents   []TranslationGroupEntity
+	Categories []TranslationGroupEntity
+}
@@ -1,8 +1,16 @@
 package entity
 
+// UserEntity is an admin user. Everything but Email and Password makes up
+// the public author profile.
 type UserEntity struct {
-	ID       int64
-	Name     string
-	Email    string
-	Password string
+	ID          int64
+	Name        string
+	Email       string
+	Password    string
+	DisplayName string
+	Bio         string
+	Avatar      string
+	// SocialLinks maps a network such as "github" to the profile URL.
+	SocialLinks map[string]string
+	Slug        string
 }
@@ -0,0 +1,7 @@
+package entity
+
+import "errors"
+
+// ErrVersionConflict is returned when an edit was based on a version that
+// has since been replaced by someone else's save.
+var ErrVersionConflict = errors.New("it was changed by someone else since it was loaded, reload and try again")
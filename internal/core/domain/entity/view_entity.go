@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+type ViewDailyEntity struct {
+	ContentID   int64
+	Day         time.Time
+	Views       int64
+	UniqueViews int64
+}
+
+type ViewStatsEntity struct {
+	ContentID   int64
+	TotalViews  int64
+	Views       int64
+	UniqueViews int64
+	Daily       []ViewDailyEntity
+}
+
+type PopularContentEntity struct {
+	Content ContentEntity
+	Views   int64
+}
@@ -3,11 +3,20 @@ package model
 import "time"
 
 type Category struct {
-	ID        int64      `gorm:"id"`
-	UserID    int64      `gorm:"user_id"`
-	Title     string     `gorm:"title"`
-	Slug      string     `gorm:"slug"`
-	CreatedAt time.Time  `gorm:"created_at"`
-	UpdatedAt *time.Time `gorm:"updated_at"`
-	User      User       `gorm:"foreignKey:UserID"`
+	ID                 int64      `gorm:"id"`
+	UserID             int64      `gorm:"user_id"`
+	Title              string     `gorm:"title"`
+	Slug               string     `gorm:"slug"`
+	Description        string     `gorm:"description"`
+	Image              string     `gorm:"image"`
+	MetaTitle          string     `gorm:"meta_title"`
+	MetaDescription    string     `gorm:"meta_description"`
+	DisplayOrder       int        `gorm:"display_order"`
+	Locale             string     `gorm:"locale"`
+	TranslationGroupID int64      `gorm:"translation_group_id"`
+	Version            int        `gorm:"version"`
+	PostCount          int64      `gorm:"->;column:post_count"`
+	CreatedAt          time.Time  `gorm:"created_at"`
+	UpdatedAt          *time.Time `gorm:"updated_at"`
+	User               User       `gorm:"foreignKey:UserID"`
 }
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type Comment struct {
+	ID          int64      `gorm:"id"`
+	ContentID   int64      `gorm:"content_id"`
+	ParentID    *int64     `gorm:"parent_id"`
+	Name        string     `gorm:"name"`
+	Email       string     `gorm:"email"`
+	Body        string     `gorm:"body"`
+	Status      string     `gorm:"status"`
+	IPAddress   string     `gorm:"ip_address"`
+	UserAgent   string     `gorm:"user_agent"`
+	Fingerprint string     `gorm:"fingerprint"`
+	SpamScore   float64    `gorm:"spam_score"`
+	SpamReasons string     `gorm:"spam_reasons"`
+	CreatedAt   time.Time  `gorm:"created_at"`
+	UpdatedAt   *time.Time `gorm:"updated_at"`
+	Content     Content    `gorm:"foreignKey:ContentID"`
+}
@@ -0,0 +1,9 @@
+package model
+
+type ContentAuthor struct {
+	ContentID int64  `gorm:"content_id"`
+	UserID    int64  `gorm:"user_id"`
+	Role      string `gorm:"role"`
+	Position  int    `gorm:"position"`
+	User      User   `gorm:"foreignKey:UserID"`
+}
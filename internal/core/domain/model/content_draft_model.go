@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type ContentDraft struct {
+	ContentID       int64     `gorm:"content_id"`
+	UserID          int64     `gorm:"user_id"`
+	CategoryID      *int64    `gorm:"category_id"`
+	BaseVersion     int       `gorm:"base_version"`
+	Title           string    `gorm:"title"`
+	Excerpt         string    `gorm:"excerpt"`
+	Description     string    `gorm:"description"`
+	Image           string    `gorm:"image"`
+	Tags            string    `gorm:"tags"`
+	MetaTitle       string    `gorm:"meta_title"`
+	MetaDescription string    `gorm:"meta_description"`
+	CanonicalUrl    string    `gorm:"canonical_url"`
+	OgImage         string    `gorm:"og_image"`
+	NoIndex         bool      `gorm:"no_index"`
+	JsonLD          string    `gorm:"json_ld"`
+	SavedAt         time.Time `gorm:"saved_at"`
+	User            User      `gorm:"foreignKey:UserID"`
+}
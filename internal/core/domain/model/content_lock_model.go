@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type ContentLock struct {
+	ContentID  int64     `gorm:"content_id"`
+	UserID     int64     `gorm:"user_id"`
+	AcquiredAt time.Time `gorm:"acquired_at"`
+	ExpiresAt  time.Time `gorm:"expires_at"`
+	User       User      `gorm:"foreignKey:UserID"`
+}
@@ -1,19 +1,43 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Content struct {
-	ID          int64      `gorm:"id"`
-	UserID      int64      `gorm:"user_id"`
-	CategoryID  int64      `gorm:"category_id"`
-	Title       string     `gorm:"title"`
-	Excerpt     string     `gorm:"excerpt"`
-	Description string     `gorm:"description"`
-	Image       string     `gorm:"image"`
-	Tags        string     `gorm:"tags"`
-	Status      string     `gorm:"status"`
-	CreatedAt   time.Time  `gorm:"created_at"`
-	UpdatedAt   *time.Time `gorm:"updated_at"`
-	User        User       `gorm:"foreignKey:UserID"`
-	Category    Category   `gorm:"foreignKey:CategoryID"`
+	ID                 int64          `gorm:"id"`
+	UserID             int64          `gorm:"user_id"`
+	CategoryID         int64          `gorm:"category_id"`
+	Title              string         `gorm:"title"`
+	Slug               string         `gorm:"slug"`
+	Excerpt            string         `gorm:"excerpt"`
+	Description        string         `gorm:"description"`
+	Image              string         `gorm:"image"`
+	Tags               string         `gorm:"tags"`
+	Status             string         `gorm:"status"`
+	Locale             string         `gorm:"locale"`
+	TranslationGroupID int64          `gorm:"translation_group_id"`
+	WordCount          int            `gorm:"word_count"`
+	ReadingTime        int            `gorm:"reading_time"`
+	Toc                string         `gorm:"toc"`
+	MetaTitle          string         `gorm:"meta_title"`
+	MetaDescription    string         `gorm:"meta_description"`
+	CanonicalUrl       string         `gorm:"canonical_url"`
+	OgImage            string         `gorm:"og_image"`
+	NoIndex            bool           `gorm:"no_index"`
+	JsonLD             string         `gorm:"json_ld"`
+	Version            int            `gorm:"version"`
+	CreatedAt          time.Time      `gorm:"created_at"`
+	UpdatedAt          *time.Time     `gorm:"updated_at"`
+	FeaturedPosition   *int           `gorm:"featured_position"`
+	FeaturedUntil      *time.Time     `gorm:"featured_until"`
+	Pinned             bool           `gorm:"pinned"`
+	DeletedAt          gorm.DeletedAt `gorm:"deleted_at"`
+	ReactionCount      int64          `gorm:"->;column:reaction_count"`
+	ViewCount          int64          `gorm:"->;column:view_count"`
+	CommentCount       int64          `gorm:"->;column:comment_count"`
+	User               User           `gorm:"foreignKey:UserID"`
+	Category           Category       `gorm:"foreignKey:CategoryID"`
 }
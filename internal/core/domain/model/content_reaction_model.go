@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type ContentReaction struct {
+	ID          int64     `gorm:"id"`
+	ContentID   int64     `gorm:"content_id"`
+	Reaction    string    `gorm:"reaction"`
+	VisitorHash string    `gorm:"visitor_hash"`
+	CreatedAt   time.Time `gorm:"created_at"`
+}
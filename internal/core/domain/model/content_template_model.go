@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+type ContentTemplate struct {
+	ID           int64      `gorm:"id"`
+	UserID       int64      `gorm:"user_id"`
+	CategoryID   *int64     `gorm:"category_id"`
+	Name         string     `gorm:"name"`
+	TitlePattern string     `gorm:"title_pattern"`
+	Description  string     `gorm:"description"`
+	Tags         string     `gorm:"tags"`
+	CreatedAt    time.Time  `gorm:"created_at"`
+	UpdatedAt    *time.Time `gorm:"updated_at"`
+	User         User       `gorm:"foreignKey:UserID"`
+	Category     *Category  `gorm:"foreignKey:CategoryID"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type ContentViewDaily struct {
+	ContentID   int64     `gorm:"content_id"`
+	Day         time.Time `gorm:"day"`
+	Views       int64     `gorm:"views"`
+	UniqueViews int64     `gorm:"unique_views"`
+}
+
+func (ContentViewDaily) TableName() string {
+	return "content_view_daily"
+}
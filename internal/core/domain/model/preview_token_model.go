@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type PreviewToken struct {
+	ID        int64      `gorm:"id"`
+	ContentID int64      `gorm:"content_id"`
+	UserID    int64      `gorm:"user_id"`
+	ExpiresAt time.Time  `gorm:"expires_at"`
+	RevokedAt *time.Time `gorm:"revoked_at"`
+	CreatedAt time.Time  `gorm:"created_at"`
+	User      User       `gorm:"foreignKey:UserID"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+type Series struct {
+	ID          int64      `gorm:"id"`
+	UserID      int64      `gorm:"user_id"`
+	Title       string     `gorm:"title"`
+	Slug        string     `gorm:"slug"`
+	Description string     `gorm:"description"`
+	PartCount   int64      `gorm:"->;column:part_count"`
+	CreatedAt   time.Time  `gorm:"created_at"`
+	UpdatedAt   *time.Time `gorm:"updated_at"`
+	User        User       `gorm:"foreignKey:UserID"`
+}
+
+func (Series) TableName() string {
+	return "series"
+}
+
+type SeriesContent struct {
+	SeriesID  int64 `gorm:"series_id"`
+	ContentID int64 `gorm:"content_id"`
+	Position  int   `gorm:"position"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type SpamBlocklist struct {
+	ID        int64     `gorm:"id"`
+	Kind      string    `gorm:"kind"`
+	Value     string    `gorm:"value"`
+	Source    string    `gorm:"source"`
+	CreatedAt time.Time `gorm:"created_at"`
+}
+
+func (SpamBlocklist) TableName() string {
+	return "spam_blocklist"
+}
@@ -3,10 +3,15 @@ package model
 import "time"
 
 type User struct {
-	ID        int64      `gorm:"id"`
-	Name      string     `gorm:"name"`
-	Email     string     `gorm:"email"`
-	Password  string     `gorm:"password"`
-	CreatedAt time.Time  `gorm:"created_at"`
-	UpdatedAt *time.Time `gorm:"updated_at"`
+	ID          int64      `gorm:"id"`
+	Name        string     `gorm:"name"`
+	Email       string     `gorm:"email"`
+	Password    string     `gorm:"password"`
+	DisplayName string     `gorm:"display_name"`
+	Bio         string     `gorm:"bio"`
+	Avatar      string     `gorm:"avatar"`
+	SocialLinks string     `gorm:"social_links"`
+	Slug        string     `gorm:"slug"`
+	CreatedAt   time.Time  `gorm:"created_at"`
+	UpdatedAt   *time.Time `gorm:"updated_at"`
 }
@@ -1,9 +1,13 @@
 package service
 
 import (
+	"blog/config"
 	"blog/internal/adapter/repository"
 	"blog/internal/core/domain/entity"
+	"blog/lib/cache"
 	"blog/lib/conv"
+	"blog/lib/locale"
+	"blog/lib/permalink"
 	"context"
 
 	"github.com/gofiber/fiber/v2/log"
@@ -11,7 +15,9 @@ import (
 
 type CategoryService interface {
 	GetCategories(ctx context.Context) ([]entity.CategoryEntity, error)
+	GetCategoriesByLocale(ctx context.Context, lang string) ([]entity.CategoryEntity, error)
 	GetCategoryByID(ctx context.Context, id int64) (*entity.CategoryEntity, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*entity.CategoryEntity, error)
 	CreateCategory(ctx context.Context, req entity.CategoryEntity) error
 	EditCategoryByID(ctx context.Context, req entity.CategoryEntity) error
 	DeleteCategory(ctx context.Context, id int64) error
@@ -19,10 +25,17 @@ type CategoryService interface {
 
 type categoryService struct {
 	categoryRepository repository.CategoryRepository
+	cache              cache.Cache
+	cfg                *config.Config
+	locales            locale.Locales
 }
 
 // CreateCategory implements CategoryService.
 func (c *categoryService) CreateCategory(ctx context.Context, req entity.CategoryEntity) error {
+	if err := c.resolveTranslation(ctx, &req, nil); err != nil {
+		return err
+	}
+
 	slug := conv.GenerateSlug(req.Title)
 	req.Slug = slug
 
@@ -33,6 +46,8 @@ func (c *categoryService) CreateCategory(ctx context.Context, req entity.Categor
 		return err
 	}
 
+	InvalidateSitemaps(c.cache)
+
 	return nil
 }
 
@@ -45,6 +60,8 @@ func (c *categoryService) DeleteCategory(ctx context.Context, id int64) error {
 		return err
 	}
 
+	InvalidateSitemaps(c.cache)
+
 	return nil
 }
 
@@ -57,6 +74,17 @@ func (c *categoryService) EditCategoryByID(ctx context.Context, req entity.Categ
 		return err
 	}
 
+	// a zero version edits whatever is current, as asked by If-Match: *
+	if req.Version == 0 {
+		req.Version = categoryData.Version
+	} else if req.Version != categoryData.Version {
+		return entity.ErrVersionConflict
+	}
+
+	if err := c.resolveTranslation(ctx, &req, categoryData); err != nil {
+		return err
+	}
+
 	slug := conv.GenerateSlug(req.Title)
 	if categoryData.Title == req.Title {
 		slug = categoryData.Slug
@@ -71,6 +99,8 @@ func (c *categoryService) EditCategoryByID(ctx context.Context, req entity.Categ
 		return err
 	}
 
+	InvalidateSitemaps(c.cache)
+
 	return nil
 }
 
@@ -87,6 +117,24 @@ func (c *categoryService) GetCategories(ctx context.Context) ([]entity.CategoryE
 	return results, nil
 }
 
+// GetCategoriesByLocale implements CategoryService. Categories without a
+// translation in lang are listed in the default locale.
+func (c *categoryService) GetCategoriesByLocale(ctx context.Context, lang string) ([]entity.CategoryEntity, error) {
+	lang, err := resolveLocale(c.locales, lang, "")
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := c.categoryRepository.GetCategoriesByLocale(ctx, lang, c.locales.Default())
+	if err != nil {
+		code = "[SERVICE] GetCategoriesByLocale - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // GetCategoryByID implements CategoryService.
 func (c *categoryService) GetCategoryByID(ctx context.Context, id int64) (*entity.CategoryEntity, error) {
 	result, err := c.categoryRepository.GetCategoryByID(ctx, id)
@@ -97,11 +145,71 @@ func (c *categoryService) GetCategoryByID(ctx context.Context, id int64) (*entit
 		return nil, err
 	}
 
+	c.linkTranslations(result)
+
+	return result, nil
+}
+
+// GetCategoryBySlug implements CategoryService.
+func (c *categoryService) GetCategoryBySlug(ctx context.Context, slug string) (*entity.CategoryEntity, error) {
+	result, err := c.categoryRepository.GetCategoryBySlug(ctx, slug)
+
+	if err != nil {
+		code = "[SERVICE] GetCategoryBySlug - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	c.linkTranslations(result)
+
 	return result, nil
 }
 
-func NewCategoryService(categoryRepo repository.CategoryRepository) CategoryService {
+// resolveTranslation settles the locale and translation group of req the
+// same way contents do.
+func (c *categoryService) resolveTranslation(ctx context.Context, req *entity.CategoryEntity, current *entity.CategoryEntity) error {
+	currentLocale := ""
+	if current != nil {
+		currentLocale = current.Locale
+	}
+
+	lang, err := resolveLocale(c.locales, req.Locale, currentLocale)
+	if err != nil {
+		return err
+	}
+	req.Locale = lang
+
+	var siblings []entity.TranslationEntity
+	switch {
+	case req.TranslationOf > 0:
+		source, err := c.categoryRepository.GetCategoryByID(ctx, req.TranslationOf)
+		if err != nil || source.ID == req.ID {
+			code = "[SERVICE] resolveTranslation - 1"
+			log.Errorw(code, err)
+			return ErrTranslationSourceNotFound
+		}
+		req.TranslationGroupID = source.TranslationGroupID
+		siblings = append(source.Translations, entity.TranslationEntity{ID: source.ID, Locale: source.Locale})
+	case current != nil:
+		req.TranslationGroupID = current.TranslationGroupID
+		siblings = current.Translations
+	}
+
+	return checkTranslationSlot(siblings, req.ID, req.Locale)
+}
+
+// linkTranslations fills the public url of each translation of result.
+func (c *categoryService) linkTranslations(result *entity.CategoryEntity) {
+	for i, translation := range result.Translations {
+		result.Translations[i].Url = permalink.Category(c.cfg.App.PublicUrl, translation.Slug)
+	}
+}
+
+func NewCategoryService(categoryRepo repository.CategoryRepository, cache cache.Cache, cfg *config.Config, locales locale.Locales) CategoryService {
 	return &categoryService{
 		categoryRepository: categoryRepo,
+		cache:              cache,
+		cfg:                cfg,
+		locales:            locales,
 	}
 }
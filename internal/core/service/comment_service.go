@@ -0,0 +1,251 @@
+package service
+
+import (
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/conv"
+	"blog/lib/spam"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	CommentActionApprove = "approve"
+	CommentActionReject  = "reject"
+	CommentActionSpam    = "spam"
+	CommentActionDelete  = "delete"
+)
+
+var (
+	ErrCommentsClosed       = errors.New("comments are not open for this content")
+	ErrCommentInvalidReply  = errors.New("the comment you are replying to does not exist")
+	ErrCommentEmptyBody     = errors.New("comment body is empty")
+	ErrCommentInvalidAction = errors.New("invalid comment action")
+)
+
+type CommentService interface {
+	GetComments(ctx context.Context, query entity.CommentQuery) ([]entity.CommentEntity, int64, int64, error)
+	GetCommentByID(ctx context.Context, id int64) (*entity.CommentEntity, error)
+	EditCommentByID(ctx context.Context, req entity.CommentEntity) error
+	BulkComments(ctx context.Context, ids []int64, action string) (int64, error)
+
+	GetCommentsFE(ctx context.Context, contentID int64) ([]entity.CommentEntity, error)
+	CreateComment(ctx context.Context, req entity.CommentEntity) error
+}
+
+type commentService struct {
+	commentRepository repository.CommentRepository
+	contentRepository repository.ContentRepository
+	spamService       SpamService
+}
+
+// CreateComment implements CommentService.
+func (cs *commentService) CreateComment(ctx context.Context, req entity.CommentEntity) error {
+	content, err := cs.contentRepository.GetContentByID(ctx, req.ContentID)
+	if err != nil || content.Status != "PUBLISH" {
+		code = "[SERVICE] CreateComment - 1"
+		log.Errorw(code, err)
+		return ErrCommentsClosed
+	}
+
+	if req.ParentID > 0 {
+		parent, err := cs.commentRepository.GetCommentByID(ctx, req.ParentID)
+		if err != nil || parent.ContentID != req.ContentID || parent.Status != entity.CommentStatusApproved {
+			code = "[SERVICE] CreateComment - 2"
+			log.Errorw(code, err)
+			return ErrCommentInvalidReply
+		}
+	}
+
+	req.Name = conv.SanitizeText(req.Name)
+	req.Body = conv.SanitizeText(req.Body)
+	if req.Body == "" || req.Name == "" {
+		return ErrCommentEmptyBody
+	}
+
+	verdict := cs.spamService.Check(ctx, spam.Submission{
+		Name:      req.Name,
+		Email:     req.Email,
+		Body:      req.Body,
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+		Honeypot:  req.Honeypot,
+		Token:     req.Token,
+	})
+
+	req.Fingerprint = spam.Fingerprint(req.Body)
+	req.SpamScore = verdict.Score
+	req.SpamReasons = verdict.Reasons
+	req.Status = entity.CommentStatusPending
+	if verdict.Spam {
+		req.Status = entity.CommentStatusSpam
+	}
+
+	_, err = cs.commentRepository.CreateComment(ctx, req)
+	if err != nil {
+		code = "[SERVICE] CreateComment - 3"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetCommentsFE implements CommentService. Only published contents show
+// their comments.
+func (cs *commentService) GetCommentsFE(ctx context.Context, contentID int64) ([]entity.CommentEntity, error) {
+	content, err := cs.contentRepository.GetContentByID(ctx, contentID)
+	if err != nil || content.Status != "PUBLISH" {
+		code = "[SERVICE] GetCommentsFE - 1"
+		log.Errorw(code, err)
+		return nil, ErrContentNotFound
+	}
+
+	results, err := cs.commentRepository.GetApprovedComments(ctx, contentID)
+	if err != nil {
+		code = "[SERVICE] GetCommentsFE - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return buildCommentThreads(results), nil
+}
+
+// buildCommentThreads nests replies under their parents. Replies whose parent
+// is not part of comments (e.g. it is still pending) are shown at the top level.
+func buildCommentThreads(comments []entity.CommentEntity) []entity.CommentEntity {
+	known := make(map[int64]bool, len(comments))
+	for _, comment := range comments {
+		known[comment.ID] = true
+	}
+
+	children := make(map[int64][]entity.CommentEntity)
+	for _, comment := range comments {
+		parentID := comment.ParentID
+		if !known[parentID] {
+			parentID = 0
+		}
+		children[parentID] = append(children[parentID], comment)
+	}
+
+	var build func(parentID int64) []entity.CommentEntity
+	build = func(parentID int64) []entity.CommentEntity {
+		threads := []entity.CommentEntity{}
+		for _, comment := range children[parentID] {
+			comment.Replies = build(comment.ID)
+			threads = append(threads, comment)
+		}
+		return threads
+	}
+
+	return build(0)
+}
+
+// BulkComments implements CommentService.
+func (cs *commentService) BulkComments(ctx context.Context, ids []int64, action string) (int64, error) {
+	var affected int64
+
+	switch action {
+	case CommentActionApprove:
+		affected, err = cs.commentRepository.UpdateCommentStatus(ctx, ids, entity.CommentStatusApproved)
+	case CommentActionReject:
+		affected, err = cs.commentRepository.UpdateCommentStatus(ctx, ids, entity.CommentStatusRejected)
+	case CommentActionSpam:
+		affected, err = cs.commentRepository.UpdateCommentStatus(ctx, ids, entity.CommentStatusSpam)
+		if err == nil {
+			cs.trainSpam(ctx, ids)
+		}
+	case CommentActionDelete:
+		affected, err = cs.commentRepository.DeleteComments(ctx, ids)
+	default:
+		return 0, ErrCommentInvalidAction
+	}
+
+	if err != nil {
+		code = "[SERVICE] BulkComments - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return affected, nil
+}
+
+// trainSpam feeds comments marked as spam to the blocklist. Training is best
+// effort and never fails the moderation action itself.
+func (cs *commentService) trainSpam(ctx context.Context, ids []int64) {
+	comments, err := cs.commentRepository.GetCommentsByIDs(ctx, ids)
+	if err != nil {
+		code = "[SERVICE] trainSpam - 1"
+		log.Errorw(code, err)
+		return
+	}
+
+	_, err = cs.spamService.Train(ctx, comments)
+	if err != nil {
+		code = "[SERVICE] trainSpam - 2"
+		log.Errorw(code, err)
+	}
+}
+
+// EditCommentByID implements CommentService.
+func (cs *commentService) EditCommentByID(ctx context.Context, req entity.CommentEntity) error {
+	commentData, err := cs.commentRepository.GetCommentByID(ctx, req.ID)
+	if err != nil {
+		code = "[SERVICE] EditCommentByID - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	if req.Name == "" {
+		req.Name = commentData.Name
+	}
+
+	req.Name = conv.SanitizeText(req.Name)
+	req.Body = conv.SanitizeText(req.Body)
+	if req.Body == "" {
+		return ErrCommentEmptyBody
+	}
+
+	err = cs.commentRepository.EditCommentByID(ctx, req)
+	if err != nil {
+		code = "[SERVICE] EditCommentByID - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetCommentByID implements CommentService.
+func (cs *commentService) GetCommentByID(ctx context.Context, id int64) (*entity.CommentEntity, error) {
+	result, err := cs.commentRepository.GetCommentByID(ctx, id)
+	if err != nil {
+		code = "[SERVICE] GetCommentByID - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetComments implements CommentService.
+func (cs *commentService) GetComments(ctx context.Context, query entity.CommentQuery) ([]entity.CommentEntity, int64, int64, error) {
+	results, totalData, totalPages, err := cs.commentRepository.GetComments(ctx, query)
+	if err != nil {
+		code = "[SERVICE] GetComments - 1"
+		log.Errorw(code, err)
+		return nil, 0, 0, err
+	}
+
+	return results, totalData, totalPages, nil
+}
+
+func NewCommentService(commentRepo repository.CommentRepository, contentRepo repository.ContentRepository, spamService SpamService) CommentService {
+	return &commentService{
+		commentRepository: commentRepo,
+		contentRepository: contentRepo,
+		spamService:       spamService,
+	}
+}
@@ -5,7 +5,17 @@ import (
 	"blog/internal/adapter/cloudflare"
 	"blog/internal/adapter/repository"
 	"blog/internal/core/domain/entity"
+	"blog/lib/cache"
+	"blog/lib/conv"
+	"blog/lib/locale"
+	"blog/lib/permalink"
+	"blog/lib/seo"
+	"blog/lib/textstat"
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2/log"
 )
@@ -13,28 +23,132 @@ import (
 type ContentService interface {
 	GetContents(ctx context.Context, query entity.QueryString) ([]entity.ContentEntity, int64, int64, error)
 	GetContentByID(ctx context.Context, id int64) (*entity.ContentEntity, error)
-	CreateContent(ctx context.Context, req entity.ContentEntity) error
+	GetContentBySlug(ctx context.Context, slug string) (*entity.ContentEntity, error)
+	GetRelatedContents(ctx context.Context, id int64, limit int) ([]entity.RelatedContentEntity, error)
+	CreateContent(ctx context.Context, req entity.ContentEntity) (int64, error)
+	CloneContent(ctx context.Context, id, userID int64) (int64, error)
 	EditContentByID(ctx context.Context, req entity.ContentEntity) error
 	DeleteContent(ctx context.Context, id int64) error
+	SetFeatured(ctx context.Context, req entity.FeatureEntity) error
+	ReorderFeatured(ctx context.Context, contentIDs []int64) error
+	SetPinned(ctx context.Context, id int64, pinned bool) error
+	AuditContent(ctx context.Context, id int64) (*entity.SeoReportEntity, error)
 	UploadImageR2(ctx context.Context, req entity.FileUploadEntity) (string, error)
+	SaveDraft(ctx context.Context, req entity.ContentDraftEntity) (*entity.ContentEntity, error)
+	DiscardDraft(ctx context.Context, id int64) error
+	PublishDraft(ctx context.Context, req entity.PublishDraftEntity) error
+	BulkContents(ctx context.Context, req entity.BulkContentEntity) (*entity.BulkReportEntity, error)
+	SetContentAuthors(ctx context.Context, contentID int64, authors []entity.ContentAuthorEntity) error
 }
 
+const (
+	relatedCacheKey = "related:"
+	relatedCacheTTL = time.Hour
+)
+
+var (
+	ErrContentNotFound    = errors.New("content not found")
+	ErrContentNotFeatured = errors.New("content is not featured")
+	ErrFeatureExpired     = errors.New("featured_until must be in the future")
+	ErrFeaturedDuplicated = errors.New("content is listed more than once")
+	ErrDraftNotFound      = errors.New("content has no draft")
+	ErrDraftIncomplete    = errors.New("draft needs a title, excerpt, description and image before it can be published")
+	ErrBulkValueRequired  = errors.New("the action needs a status, category_id or tags to apply")
+	ErrContentIncomplete  = errors.New("title, description and category_id are required")
+	ErrAuthorsRequired    = errors.New("at least one author with the author role is required")
+	ErrAuthorDuplicated   = errors.New("a user can only be credited once")
+	ErrContentPublished   = errors.New("content is published, save changes with PUT /api/admin/contents/:contentId/draft and apply them with POST /api/admin/contents/:contentId/draft/publish")
+)
+
 type contentService struct {
-	contentRepository repository.ContentRepository
-	cfg               *config.Config
-	r2                cloudflare.CloudFlareR2Adapter
+	contentRepository  repository.ContentRepository
+	cfg                *config.Config
+	r2                 cloudflare.CloudFlareR2Adapter
+	cache              cache.Cache
+	locales            locale.Locales
+	templateRepository repository.TemplateRepository
 }
 
-// CreateContent implements ContentService.
-func (c *contentService) CreateContent(ctx context.Context, req entity.ContentEntity) error {
-	err = c.contentRepository.CreateContent(ctx, req)
+// CreateContent implements ContentService. With a TemplateID, the fields
+// left empty are filled from that template.
+func (c *contentService) CreateContent(ctx context.Context, req entity.ContentEntity) (int64, error) {
+	if err := seo.ValidateJsonLD(req.JsonLD); err != nil {
+		return 0, err
+	}
+
+	if req.TemplateID > 0 {
+		template, err := c.templateRepository.GetTemplateByID(ctx, req.TemplateID)
+		if err != nil {
+			code = "[SERVICE] CreateContent - 2"
+			log.Errorw(code, err)
+			return 0, ErrTemplateNotFound
+		}
+
+		applyTemplate(&req, *template, time.Now())
+	}
+
+	if req.Title == "" || req.Description == "" || req.CategoryID == 0 {
+		return 0, ErrContentIncomplete
+	}
+
+	if err := c.resolveTranslation(ctx, &req, nil); err != nil {
+		return 0, err
+	}
+
+	req.Slug = conv.GenerateSlug(req.Title)
+	measureContent(&req)
+
+	id, err := c.contentRepository.CreateContent(ctx, req)
 	if err != nil {
 		code = "[SERVICE] CreateContent - 1"
 		log.Errorw(code, err)
-		return err
+		return 0, err
 	}
 
-	return nil
+	InvalidateSitemaps(c.cache)
+
+	return id, nil
+}
+
+// CloneContent implements ContentService. The copy is a draft of its own,
+// outside the source's translation group, series and featured slots, with
+// a fresh slug and userID as its author.
+func (c *contentService) CloneContent(ctx context.Context, id, userID int64) (int64, error) {
+	source, err := c.contentRepository.GetContentByID(ctx, id)
+	if err != nil {
+		code = "[SERVICE] CloneContent - 1"
+		log.Errorw(code, err)
+		return 0, ErrContentNotFound
+	}
+
+	req := entity.ContentEntity{
+		Title:           source.Title + " (copy)",
+		Excerpt:         source.Excerpt,
+		Description:     source.Description,
+		Image:           source.Image,
+		Tags:            source.Tags,
+		Status:          "DRAFT",
+		Locale:          source.Locale,
+		MetaTitle:       source.MetaTitle,
+		MetaDescription: source.MetaDescription,
+		OgImage:         source.OgImage,
+		NoIndex:         source.NoIndex,
+		JsonLD:          source.JsonLD,
+		CategoryID:      source.CategoryID,
+		UserID:          userID,
+	}
+
+	req.Slug = conv.GenerateSlug(req.Title)
+	measureContent(&req)
+
+	newID, err := c.contentRepository.CreateContent(ctx, req)
+	if err != nil {
+		code = "[SERVICE] CloneContent - 2"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return newID, nil
 }
 
 // DeleteContent implements ContentService.
@@ -46,21 +160,213 @@ func (c *contentService) DeleteContent(ctx context.Context, id int64) error {
 		return err
 	}
 
+	InvalidateSitemaps(c.cache)
+	c.cache.DeletePrefix(relatedCacheKey)
+
 	return nil
 }
 
-// EditContentByID implements ContentService.
+// EditContentByID implements ContentService. A published content is only
+// edited in place when it is taken down, other changes go through its draft.
 func (c *contentService) EditContentByID(ctx context.Context, req entity.ContentEntity) error {
-	err = c.contentRepository.EditContentByID(ctx, req)
+	if err := seo.ValidateJsonLD(req.JsonLD); err != nil {
+		return err
+	}
+
+	contentData, err := c.contentRepository.GetContentByID(ctx, req.ID)
 	if err != nil {
 		code = "[SERVICE] EditContentByID - 1"
 		log.Errorw(code, err)
 		return err
 	}
 
+	// a zero version edits whatever is current, as asked by If-Match: *
+	if req.Version == 0 {
+		req.Version = contentData.Version
+	} else if req.Version != contentData.Version {
+		return entity.ErrVersionConflict
+	}
+
+	if contentData.Status == "PUBLISH" && req.Status == "PUBLISH" {
+		return ErrContentPublished
+	}
+
+	if err := c.resolveTranslation(ctx, &req, contentData); err != nil {
+		return err
+	}
+
+	req.Slug = resolveSlug(*contentData, req.Title, req.RegenerateSlug)
+	measureContent(&req)
+
+	err = c.contentRepository.EditContentByID(ctx, req)
+	if err != nil {
+		code = "[SERVICE] EditContentByID - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	InvalidateSitemaps(c.cache)
+	c.invalidateRelated(*contentData, req)
+
+	return nil
+}
+
+// SaveDraft implements ContentService. The content itself is left as is and
+// returned with the saved draft; the draft remembers the version it was
+// started from so editors can tell when the content moved on underneath it.
+func (c *contentService) SaveDraft(ctx context.Context, req entity.ContentDraftEntity) (*entity.ContentEntity, error) {
+	if err := seo.ValidateJsonLD(req.JsonLD); err != nil {
+		return nil, err
+	}
+
+	contentData, err := c.contentRepository.GetContentByID(ctx, req.ContentID)
+	if err != nil {
+		code = "[SERVICE] SaveDraft - 1"
+		log.Errorw(code, err)
+		return nil, ErrContentNotFound
+	}
+
+	req.BaseVersion = contentData.Version
+	if contentData.Draft != nil {
+		req.BaseVersion = contentData.Draft.BaseVersion
+	}
+	req.SavedAt = time.Now().Truncate(time.Microsecond)
+
+	err = c.contentRepository.SaveDraft(ctx, req)
+	if err != nil {
+		code = "[SERVICE] SaveDraft - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	contentData.Draft = &req
+
+	return contentData, nil
+}
+
+// DiscardDraft implements ContentService.
+func (c *contentService) DiscardDraft(ctx context.Context, id int64) error {
+	err = c.contentRepository.DeleteDraft(ctx, id)
+	if err != nil {
+		code = "[SERVICE] DiscardDraft - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// PublishDraft implements ContentService. The draft replaces the editable
+// fields of the content, which keeps its status, locale and author, and is
+// then dropped. The version follows the same rules as EditContentByID.
+func (c *contentService) PublishDraft(ctx context.Context, publish entity.PublishDraftEntity) error {
+	contentData, err := c.contentRepository.GetContentByID(ctx, publish.ContentID)
+	if err != nil {
+		code = "[SERVICE] PublishDraft - 1"
+		log.Errorw(code, err)
+		return ErrContentNotFound
+	}
+
+	draft := contentData.Draft
+	if draft == nil {
+		return ErrDraftNotFound
+	}
+
+	if draft.Title == "" || draft.Excerpt == "" || draft.Description == "" || draft.Image == "" {
+		return ErrDraftIncomplete
+	}
+
+	version := publish.Version
+	if version == 0 {
+		version = contentData.Version
+	} else if version != contentData.Version {
+		return entity.ErrVersionConflict
+	}
+
+	// the content moved on since the draft was started, publishing it
+	// would silently undo those changes
+	if draft.BaseVersion != contentData.Version && !publish.Rebase {
+		return entity.ErrVersionConflict
+	}
+
+	req := *contentData
+	req.Version = version
+	req.Title = draft.Title
+	req.Excerpt = draft.Excerpt
+	req.Description = draft.Description
+	req.Image = draft.Image
+	req.Tags = draft.Tags
+	req.MetaTitle = draft.MetaTitle
+	req.MetaDescription = draft.MetaDescription
+	req.CanonicalUrl = draft.CanonicalUrl
+	req.OgImage = draft.OgImage
+	req.NoIndex = draft.NoIndex
+	req.JsonLD = draft.JsonLD
+	if draft.CategoryID > 0 {
+		req.CategoryID = draft.CategoryID
+	}
+
+	req.Slug = resolveSlug(*contentData, req.Title, publish.RegenerateSlug)
+	measureContent(&req)
+
+	err = c.contentRepository.PublishDraft(ctx, req, draft.SavedAt)
+	if err != nil {
+		code = "[SERVICE] PublishDraft - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	InvalidateSitemaps(c.cache)
+	c.invalidateRelated(*contentData, req)
+
 	return nil
 }
 
+// BulkContents implements ContentService. Repeated IDs are applied once.
+func (c *contentService) BulkContents(ctx context.Context, req entity.BulkContentEntity) (*entity.BulkReportEntity, error) {
+	switch {
+	case req.Action == entity.BulkActionStatus && req.Status == "",
+		req.Action == entity.BulkActionCategory && req.CategoryID == 0,
+		(req.Action == entity.BulkActionAddTags || req.Action == entity.BulkActionRemoveTags) && len(req.Tags) == 0:
+		return nil, ErrBulkValueRequired
+	}
+
+	seen := map[int64]bool{}
+	ids := []int64{}
+	for _, id := range req.IDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	req.IDs = ids
+
+	results, err := c.contentRepository.BulkContents(ctx, req)
+	if err != nil {
+		code = "[SERVICE] BulkContents - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	applied := !req.DryRun
+	for _, result := range results {
+		if result.Error != "" {
+			applied = false
+		}
+	}
+
+	if applied {
+		InvalidateSitemaps(c.cache)
+		c.cache.DeletePrefix(relatedCacheKey)
+	}
+
+	return &entity.BulkReportEntity{
+		Applied: applied,
+		DryRun:  req.DryRun,
+		Results: results,
+	}, nil
+}
+
 // GetContentByID implements ContentService.
 func (c *contentService) GetContentByID(ctx context.Context, id int64) (*entity.ContentEntity, error) {
 	result, err := c.contentRepository.GetContentByID(ctx, id)
@@ -70,11 +376,66 @@ func (c *contentService) GetContentByID(ctx context.Context, id int64) (*entity.
 		return nil, err
 	}
 
+	c.linkTranslations(result)
+
+	return result, nil
+}
+
+// GetContentBySlug implements ContentService.
+func (c *contentService) GetContentBySlug(ctx context.Context, slug string) (*entity.ContentEntity, error) {
+	result, err := c.contentRepository.GetContentBySlug(ctx, slug)
+	if err != nil {
+		code = "[SERVICE] GetContentBySlug - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	c.linkTranslations(result)
+
 	return result, nil
 }
 
+// GetRelatedContents implements ContentService. Results are cached per post
+// and dropped when that post is edited.
+func (c *contentService) GetRelatedContents(ctx context.Context, id int64, limit int) ([]entity.RelatedContentEntity, error) {
+	cacheKey := fmt.Sprintf("%s%d:%d", relatedCacheKey, id, limit)
+	if cached, ok := c.cache.Get(cacheKey); ok {
+		return cached.([]entity.RelatedContentEntity), nil
+	}
+
+	content, err := c.contentRepository.GetContentByID(ctx, id)
+	if err != nil || content.Status != "PUBLISH" {
+		code = "[SERVICE] GetRelatedContents - 1"
+		log.Errorw(code, err)
+		return nil, ErrContentNotFound
+	}
+
+	results, err := c.contentRepository.GetRelatedContents(ctx, id, limit)
+	if err != nil {
+		code = "[SERVICE] GetRelatedContents - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	c.cache.Set(cacheKey, results, relatedCacheTTL)
+
+	return results, nil
+}
+
 // GetContents implements ContentService.
 func (c *contentService) GetContents(ctx context.Context, query entity.QueryString) ([]entity.ContentEntity, int64, int64, error) {
+	if query.Locale != "" {
+		query.Locale = c.locales.Normalize(query.Locale)
+		if !c.locales.IsSupported(query.Locale) {
+			return nil, 0, 0, ErrLocaleNotSupported
+		}
+
+		query.FallbackLocale = c.locales.Normalize(query.FallbackLocale)
+		if query.FallbackLocale == "" {
+			query.FallbackLocale = c.locales.Default()
+		}
+	}
+
 	results, totalData, totalPages, err := c.contentRepository.GetContents(ctx, query)
 	if err != nil {
 		code = "[SERVICE] GetContents - 1"
@@ -85,6 +446,269 @@ func (c *contentService) GetContents(ctx context.Context, query entity.QueryStri
 	return results, totalData, totalPages, nil
 }
 
+// SetFeatured implements ContentService.
+func (c *contentService) SetFeatured(ctx context.Context, req entity.FeatureEntity) error {
+	_, err := c.contentRepository.GetContentByID(ctx, req.ContentID)
+	if err != nil {
+		code = "[SERVICE] SetFeatured - 1"
+		log.Errorw(code, err)
+		return ErrContentNotFound
+	}
+
+	if req.Featured && req.Until != nil && !req.Until.After(time.Now()) {
+		return ErrFeatureExpired
+	}
+
+	err = c.contentRepository.SetFeatured(ctx, req)
+	if err != nil {
+		code = "[SERVICE] SetFeatured - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// ReorderFeatured implements ContentService.
+func (c *contentService) ReorderFeatured(ctx context.Context, contentIDs []int64) error {
+	seen := map[int64]bool{}
+	for _, contentID := range contentIDs {
+		if seen[contentID] {
+			return fmt.Errorf("%w: %d", ErrFeaturedDuplicated, contentID)
+		}
+		seen[contentID] = true
+	}
+
+	featured, err := c.contentRepository.GetFeaturedIDs(ctx, contentIDs)
+	if err != nil {
+		code = "[SERVICE] ReorderFeatured - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	isFeatured := map[int64]bool{}
+	for _, contentID := range featured {
+		isFeatured[contentID] = true
+	}
+
+	for _, contentID := range contentIDs {
+		if !isFeatured[contentID] {
+			return fmt.Errorf("%w: %d", ErrContentNotFeatured, contentID)
+		}
+	}
+
+	err = c.contentRepository.ReorderFeatured(ctx, contentIDs)
+	if err != nil {
+		code = "[SERVICE] ReorderFeatured - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// SetPinned implements ContentService.
+func (c *contentService) SetPinned(ctx context.Context, id int64, pinned bool) error {
+	_, err := c.contentRepository.GetContentByID(ctx, id)
+	if err != nil {
+		code = "[SERVICE] SetPinned - 1"
+		log.Errorw(code, err)
+		return ErrContentNotFound
+	}
+
+	err = c.contentRepository.SetPinned(ctx, id, pinned)
+	if err != nil {
+		code = "[SERVICE] SetPinned - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// SetContentAuthors implements ContentService. authors are credited in the
+// given order and must include at least one user with the author role.
+func (c *contentService) SetContentAuthors(ctx context.Context, contentID int64, authors []entity.ContentAuthorEntity) error {
+	seen := map[int64]bool{}
+	hasAuthor := false
+	for _, val := range authors {
+		if seen[val.User.ID] {
+			return ErrAuthorDuplicated
+		}
+		seen[val.User.ID] = true
+
+		if val.Role == entity.AuthorRoleAuthor {
+			hasAuthor = true
+		}
+	}
+
+	if !hasAuthor {
+		return ErrAuthorsRequired
+	}
+
+	_, err := c.contentRepository.GetContentByID(ctx, contentID)
+	if err != nil {
+		code = "[SERVICE] SetContentAuthors - 1"
+		log.Errorw(code, err)
+		return ErrContentNotFound
+	}
+
+	err = c.contentRepository.SetContentAuthors(ctx, contentID, authors)
+	if err != nil {
+		code = "[SERVICE] SetContentAuthors - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	c.cache.DeletePrefix(relatedCacheKey)
+
+	return nil
+}
+
+// AuditContent implements ContentService.
+func (c *contentService) AuditContent(ctx context.Context, id int64) (*entity.SeoReportEntity, error) {
+	content, err := c.contentRepository.GetContentByID(ctx, id)
+	if err != nil {
+		code = "[SERVICE] AuditContent - 1"
+		log.Errorw(code, err)
+		return nil, ErrContentNotFound
+	}
+
+	issues := seo.Audit(seo.Page{
+		Title:           content.Title,
+		Excerpt:         content.Excerpt,
+		Body:            content.Description,
+		Image:           content.Image,
+		MetaTitle:       content.MetaTitle,
+		MetaDescription: content.MetaDescription,
+		CanonicalUrl:    content.CanonicalUrl,
+		OgImage:         content.OgImage,
+		NoIndex:         content.NoIndex,
+		JsonLD:          content.JsonLD,
+	})
+
+	title := content.MetaTitle
+	if title == "" {
+		title = content.Title
+	}
+
+	duplicates, err := c.contentRepository.GetDuplicateTitles(ctx, id, title)
+	if err != nil {
+		code = "[SERVICE] AuditContent - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	for _, val := range duplicates {
+		issues = append(issues, seo.Issue{
+			Field:    "title",
+			Severity: seo.SeverityWarning,
+			Message:  fmt.Sprintf("title is also used by content %d (%s)", val.ID, val.Slug),
+		})
+	}
+
+	report := entity.SeoReportEntity{
+		ContentID: id,
+		Score:     seo.Score(issues),
+		Issues:    []entity.SeoIssueEntity{},
+	}
+
+	for _, issue := range issues {
+		report.Issues = append(report.Issues, entity.SeoIssueEntity{
+			Field:    issue.Field,
+			Severity: issue.Severity,
+			Message:  issue.Message,
+		})
+	}
+
+	return &report, nil
+}
+
+// invalidateRelated drops the cached related contents after an edit. The
+// content's own lists are always dropped; when it changed in a way that shows
+// in, or moves it within, the lists of other contents, all of them are.
+func (c *contentService) invalidateRelated(before, after entity.ContentEntity) {
+	if before.Status != after.Status || before.Title != after.Title || before.Slug != after.Slug ||
+		before.Excerpt != after.Excerpt || before.Image != after.Image || before.CategoryID != after.CategoryID ||
+		before.Locale != after.Locale || strings.Join(before.Tags, ",") != strings.Join(after.Tags, ",") {
+		c.cache.DeletePrefix(relatedCacheKey)
+		return
+	}
+
+	c.cache.DeletePrefix(fmt.Sprintf("%s%d:", relatedCacheKey, after.ID))
+}
+
+// resolveSlug returns the slug of current once it is saved under title.
+// Drafts follow their title, while a published content keeps its slug so
+// its links stay valid, unless regenerate asks for a new one.
+func resolveSlug(current entity.ContentEntity, title string, regenerate bool) string {
+	if regenerate || (current.Status != "PUBLISH" && current.Title != title) {
+		return conv.GenerateSlug(title)
+	}
+
+	return current.Slug
+}
+
+// measureContent computes the reading statistics and table of contents of
+// req, adding anchor ids to the headings of its description.
+func measureContent(req *entity.ContentEntity) {
+	description, stats := textstat.Analyze(req.Description)
+
+	req.Description = description
+	req.WordCount = stats.WordCount
+	req.ReadingTime = stats.ReadingTime
+	req.Toc = []entity.HeadingEntity{}
+	for _, heading := range stats.Headings {
+		req.Toc = append(req.Toc, entity.HeadingEntity{
+			Level: heading.Level,
+			Text:  heading.Text,
+			ID:    heading.ID,
+		})
+	}
+}
+
+// resolveTranslation settles the locale and translation group of req. A
+// new content starts its own group unless TranslationOf names a source,
+// and an edited one keeps the group of current. No two contents of a
+// group may share a locale.
+func (c *contentService) resolveTranslation(ctx context.Context, req *entity.ContentEntity, current *entity.ContentEntity) error {
+	currentLocale := ""
+	if current != nil {
+		currentLocale = current.Locale
+	}
+
+	lang, err := resolveLocale(c.locales, req.Locale, currentLocale)
+	if err != nil {
+		return err
+	}
+	req.Locale = lang
+
+	var siblings []entity.TranslationEntity
+	switch {
+	case req.TranslationOf > 0:
+		source, err := c.contentRepository.GetContentByID(ctx, req.TranslationOf)
+		if err != nil || source.ID == req.ID {
+			code = "[SERVICE] resolveTranslation - 1"
+			log.Errorw(code, err)
+			return ErrTranslationSourceNotFound
+		}
+		req.TranslationGroupID = source.TranslationGroupID
+		siblings = append(source.Translations, entity.TranslationEntity{ID: source.ID, Locale: source.Locale})
+	case current != nil:
+		req.TranslationGroupID = current.TranslationGroupID
+		siblings = current.Translations
+	}
+
+	return checkTranslationSlot(siblings, req.ID, req.Locale)
+}
+
+// linkTranslations fills the public url of each translation of result.
+func (c *contentService) linkTranslations(result *entity.ContentEntity) {
+	for i, translation := range result.Translations {
+		result.Translations[i].Url = permalink.Content(c.cfg.App.PublicUrl, translation.Slug)
+	}
+}
+
 // UploadImageR2 implements ContentService.
 func (c *contentService) UploadImageR2(ctx context.Context, req entity.FileUploadEntity) (string, error) {
 	urlImage, err := c.r2.UploadImage(&req)
@@ -97,10 +721,13 @@ func (c *contentService) UploadImageR2(ctx context.Context, req entity.FileUploa
 	return urlImage, nil
 }
 
-func NewContentService(contentRepo repository.ContentRepository, cfg *config.Config, r2 cloudflare.CloudFlareR2Adapter) ContentService {
+func NewContentService(contentRepo repository.ContentRepository, cfg *config.Config, r2 cloudflare.CloudFlareR2Adapter, cache cache.Cache, locales locale.Locales, templateRepo repository.TemplateRepository) ContentService {
 	return &contentService{
-		contentRepository: contentRepo,
-		cfg:               cfg,
-		r2:                r2,
+		contentRepository:  contentRepo,
+		cfg:                cfg,
+		r2:                 r2,
+		cache:              cache,
+		locales:            locales,
+		templateRepository: templateRepo,
 	}
 }
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/cache"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	dashboardCacheKey = "dashboard:"
+	dashboardCacheTTL = time.Minute
+)
+
+type DashboardService interface {
+	GetDashboard(ctx context.Context, query entity.DashboardQuery) (*entity.DashboardEntity, error)
+}
+
+type dashboardService struct {
+	dashboardRepository repository.DashboardRepository
+	cache               cache.Cache
+}
+
+// GetDashboard implements DashboardService. Results are cached briefly per
+// query so a busy admin panel does not rerun every aggregate on each load.
+func (d *dashboardService) GetDashboard(ctx context.Context, query entity.DashboardQuery) (*entity.DashboardEntity, error) {
+	cacheKey := fmt.Sprintf("%s%d:%s:%d:%d", dashboardCacheKey, query.StaleDays, query.Interval, query.Periods, query.RecentLimit)
+	if cached, ok := d.cache.Get(cacheKey); ok {
+		return cached.(*entity.DashboardEntity), nil
+	}
+
+	resp := entity.DashboardEntity{GeneratedAt: time.Now()}
+
+	resp.StatusCounts, err = d.dashboardRepository.GetStatusCounts(ctx)
+	if err != nil {
+		code = "[SERVICE] GetDashboard - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	for _, status := range resp.StatusCounts {
+		resp.TotalContents += status.Total
+	}
+
+	resp.Categories, err = d.dashboardRepository.GetCategoryCounts(ctx)
+	if err != nil {
+		code = "[SERVICE] GetDashboard - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resp.Authors, err = d.dashboardRepository.GetAuthorCounts(ctx)
+	if err != nil {
+		code = "[SERVICE] GetDashboard - 3"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resp.RecentActivity, err = d.dashboardRepository.GetRecentActivity(ctx, query.RecentLimit)
+	if err != nil {
+		code = "[SERVICE] GetDashboard - 4"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resp.StaleDrafts, err = d.dashboardRepository.GetStaleDrafts(ctx, time.Now().AddDate(0, 0, -query.StaleDays))
+	if err != nil {
+		code = "[SERVICE] GetDashboard - 5"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resp.Cadence, err = d.dashboardRepository.GetCadence(ctx, query.Interval, query.Periods)
+	if err != nil {
+		code = "[SERVICE] GetDashboard - 6"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resp.PendingComments, err = d.dashboardRepository.CountPendingComments(ctx)
+	if err != nil {
+		code = "[SERVICE] GetDashboard - 7"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	d.cache.Set(cacheKey, &resp, dashboardCacheTTL)
+
+	return &resp, nil
+}
+
+func NewDashboardService(dashboardRepo repository.DashboardRepository, cache cache.Cache) DashboardService {
+	return &dashboardService{
+		dashboardRepository: dashboardRepo,
+		cache:               cache,
+	}
+}
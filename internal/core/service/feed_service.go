@@ -0,0 +1,132 @@
+package service
+
+import (
+	"blog/config"
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/permalink"
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const feedMaxItems = 100
+
+type FeedService interface {
+	GetFeed(ctx context.Context, query entity.FeedQuery) (*entity.FeedEntity, error)
+}
+
+type feedService struct {
+	contentRepository  repository.ContentRepository
+	categoryRepository repository.CategoryRepository
+	cfg                *config.Config
+}
+
+// GetFeed implements FeedService.
+func (f *feedService) GetFeed(ctx context.Context, query entity.FeedQuery) (*entity.FeedEntity, error) {
+	if query.Limit <= 0 || query.Limit > feedMaxItems {
+		query.Limit = 20
+	}
+
+	feed := entity.FeedEntity{
+		Title:       f.cfg.App.SiteTitle,
+		Description: f.cfg.App.SiteDescription,
+		Link:        permalink.Home(f.cfg.App.PublicUrl),
+	}
+
+	contentQuery := entity.QueryString{
+		Limit:     query.Limit,
+		Page:      1,
+		OrderBy:   "created_at",
+		OrderType: "desc",
+		Status:    "PUBLISH",
+		Tag:       query.Tag,
+	}
+
+	if query.CategorySlug != "" {
+		category, err := f.categoryRepository.GetCategoryBySlug(ctx, query.CategorySlug)
+		if err != nil {
+			code = "[SERVICE] GetFeed - 1"
+			log.Errorw(code, err)
+			return nil, err
+		}
+
+		contentQuery.CategoryID = category.ID
+		feed.Title = f.cfg.App.SiteTitle + " - " + category.Title
+		feed.Link = permalink.Category(f.cfg.App.PublicUrl, category.Slug)
+		if category.Description != "" {
+			feed.Description = category.Description
+		}
+	}
+
+	if query.Tag != "" {
+		feed.Title = f.cfg.App.SiteTitle + " - #" + query.Tag
+		feed.Link = permalink.Tag(f.cfg.App.PublicUrl, query.Tag)
+	}
+
+	results, _, _, err := f.contentRepository.GetContents(ctx, contentQuery)
+	if err != nil {
+		code = "[SERVICE] GetFeed - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	for _, result := range results {
+		item := entity.FeedItemEntity{
+			ID:          result.ID,
+			Title:       result.Title,
+			Link:        permalink.Content(f.cfg.App.PublicUrl, result.Slug),
+			Summary:     result.Excerpt,
+			Image:       result.Image,
+			Authors:     authorNames(result),
+			Category:    result.Category.Title,
+			PublishedAt: result.CreatedAt,
+			UpdatedAt:   result.UpdatedAt,
+		}
+
+		if query.FullContent {
+			item.Content = result.Description
+		}
+
+		for _, tag := range result.Tags {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				item.Tags = append(item.Tags, tag)
+			}
+		}
+
+		if item.UpdatedAt.After(feed.UpdatedAt) {
+			feed.UpdatedAt = item.UpdatedAt
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+
+	return &feed, nil
+}
+
+// authorNames lists who a content is credited to, in credit order.
+func authorNames(result entity.ContentEntity) []string {
+	if len(result.Authors) == 0 {
+		return []string{result.User.Name}
+	}
+
+	names := []string{}
+	for _, val := range result.Authors {
+		if val.User.DisplayName != "" {
+			names = append(names, val.User.DisplayName)
+		} else {
+			names = append(names, val.User.Name)
+		}
+	}
+
+	return names
+}
+
+func NewFeedService(contentRepo repository.ContentRepository, categoryRepo repository.CategoryRepository, cfg *config.Config) FeedService {
+	return &feedService{
+		contentRepository:  contentRepo,
+		categoryRepository: categoryRepo,
+		cfg:                cfg,
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type LockService interface {
+	AcquireLock(ctx context.Context, contentID, userID int64, force bool) (*entity.ContentLockEntity, error)
+	Heartbeat(ctx context.Context, contentID, userID int64) (*entity.ContentLockEntity, error)
+	ReleaseLock(ctx context.Context, contentID, userID int64) error
+}
+
+var (
+	ErrContentLocked = errors.New("content is being edited by someone else")
+	ErrLockNotHeld   = errors.New("edit lock is not held, it expired or was taken over")
+)
+
+// lockTTL is how long a lock survives without a heartbeat. Editors are
+// expected to send one every 30 seconds or so.
+const lockTTL = 2 * time.Minute
+
+type lockService struct {
+	lockRepository repository.LockRepository
+	contentService ContentService
+}
+
+// AcquireLock implements LockService. Locks are advisory: they don't block
+// saves, they tell editors who else is working on a content. Any admin may
+// force a takeover, there are no finer roles to restrict it to. When the lock
+// is held by someone else it returns ErrContentLocked with the current lock.
+func (l *lockService) AcquireLock(ctx context.Context, contentID, userID int64, force bool) (*entity.ContentLockEntity, error) {
+	_, err := l.contentService.GetContentByID(ctx, contentID)
+	if err != nil {
+		code = "[SERVICE] AcquireLock - 1"
+		log.Errorw(code, err)
+		return nil, ErrContentNotFound
+	}
+
+	acquired, err := l.lockRepository.AcquireLock(ctx, contentID, userID, time.Now().Add(lockTTL), force)
+	if err != nil {
+		code = "[SERVICE] AcquireLock - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	result, err := l.lockRepository.GetLock(ctx, contentID)
+	if err != nil {
+		code = "[SERVICE] AcquireLock - 3"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	if !acquired {
+		return result, ErrContentLocked
+	}
+
+	return result, nil
+}
+
+// Heartbeat implements LockService. A lock that expired or was taken over
+// can't be extended, the editor has to acquire it again.
+func (l *lockService) Heartbeat(ctx context.Context, contentID, userID int64) (*entity.ContentLockEntity, error) {
+	refreshed, err := l.lockRepository.RefreshLock(ctx, contentID, userID, time.Now().Add(lockTTL))
+	if err != nil {
+		code = "[SERVICE] Heartbeat - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	result, err := l.lockRepository.GetLock(ctx, contentID)
+	if err != nil {
+		code = "[SERVICE] Heartbeat - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	if !refreshed {
+		return result, ErrLockNotHeld
+	}
+
+	return result, nil
+}
+
+// ReleaseLock implements LockService.
+func (l *lockService) ReleaseLock(ctx context.Context, contentID, userID int64) error {
+	return l.lockRepository.ReleaseLock(ctx, contentID, userID)
+}
+
+func NewLockService(lockRepo repository.LockRepository, contentService ContentService) LockService {
+	return &lockService{
+		lockRepository: lockRepo,
+		contentService: contentService,
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"blog/config"
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/permalink"
+	"blog/lib/preview"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type PreviewService interface {
+	GetPreviews(ctx context.Context, contentID int64) ([]entity.PreviewTokenEntity, error)
+	CreatePreview(ctx context.Context, contentID, userID int64, ttl time.Duration) (*entity.PreviewTokenEntity, error)
+	RevokePreview(ctx context.Context, contentID, id int64) error
+	GetContentByPreview(ctx context.Context, token string) (*entity.ContentEntity, error)
+}
+
+var (
+	ErrPreviewNotFound  = errors.New("preview link not found")
+	ErrPreviewPublished = errors.New("content is already published")
+	ErrPreviewInvalid   = errors.New("preview link is invalid or has been revoked")
+)
+
+type previewService struct {
+	previewRepository repository.PreviewRepository
+	contentService    ContentService
+	signer            preview.Signer
+	cfg               *config.Config
+}
+
+// CreatePreview implements PreviewService. A zero ttl uses the configured
+// default.
+func (p *previewService) CreatePreview(ctx context.Context, contentID, userID int64, ttl time.Duration) (*entity.PreviewTokenEntity, error) {
+	content, err := p.contentService.GetContentByID(ctx, contentID)
+	if err != nil {
+		code = "[SERVICE] CreatePreview - 1"
+		log.Errorw(code, err)
+		return nil, ErrContentNotFound
+	}
+
+	if content.Status == "PUBLISH" {
+		return nil, ErrPreviewPublished
+	}
+
+	if ttl <= 0 {
+		ttl = p.signer.TTL()
+	}
+
+	req := entity.PreviewTokenEntity{
+		ContentID: contentID,
+		ExpiresAt: time.Now().Add(ttl).Truncate(time.Second),
+		User: entity.UserEntity{
+			ID: userID,
+		},
+	}
+
+	req.ID, err = p.previewRepository.CreatePreviewToken(ctx, req)
+	if err != nil {
+		code = "[SERVICE] CreatePreview - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	req.Token = p.signer.Sign(preview.Claims{
+		ID:        req.ID,
+		ContentID: req.ContentID,
+		ExpiresAt: req.ExpiresAt,
+	})
+	req.Url = permalink.Preview(p.cfg.App.PublicUrl, req.Token)
+	req.CreatedAt = time.Now()
+
+	return &req, nil
+}
+
+// GetPreviews implements PreviewService.
+func (p *previewService) GetPreviews(ctx context.Context, contentID int64) ([]entity.PreviewTokenEntity, error) {
+	results, err := p.previewRepository.GetPreviewTokens(ctx, contentID)
+	if err != nil {
+		code = "[SERVICE] GetPreviews - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// RevokePreview implements PreviewService.
+func (p *previewService) RevokePreview(ctx context.Context, contentID, id int64) error {
+	stored, err := p.previewRepository.GetPreviewTokenByID(ctx, id)
+	if err != nil || stored.ContentID != contentID {
+		code = "[SERVICE] RevokePreview - 1"
+		log.Errorw(code, err)
+		return ErrPreviewNotFound
+	}
+
+	err = p.previewRepository.RevokePreviewToken(ctx, contentID, id)
+	if err != nil {
+		code = "[SERVICE] RevokePreview - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetContentByPreview implements PreviewService. The token must carry a
+// valid signature, be unexpired and still be on record unrevoked for the
+// same content.
+func (p *previewService) GetContentByPreview(ctx context.Context, token string) (*entity.ContentEntity, error) {
+	claims, err := p.signer.Verify(token)
+	if err != nil {
+		if errors.Is(err, preview.ErrExpiredToken) {
+			return nil, err
+		}
+		return nil, ErrPreviewInvalid
+	}
+
+	stored, err := p.previewRepository.GetPreviewTokenByID(ctx, claims.ID)
+	if err != nil || stored.RevokedAt != nil || stored.ContentID != claims.ContentID {
+		code = "[SERVICE] GetContentByPreview - 1"
+		log.Errorw(code, err)
+		return nil, ErrPreviewInvalid
+	}
+
+	result, err := p.contentService.GetContentByID(ctx, claims.ContentID)
+	if err != nil {
+		code = "[SERVICE] GetContentByPreview - 2"
+		log.Errorw(code, err)
+		return nil, ErrContentNotFound
+	}
+
+	return result, nil
+}
+
+func NewPreviewService(previewRepo repository.PreviewRepository, contentService ContentService, signer preview.Signer, cfg *config.Config) PreviewService {
+	return &previewService{
+		previewRepository: previewRepo,
+		contentService:    contentService,
+		signer:            signer,
+		cfg:               cfg,
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+var (
+	ErrReactionInvalid       = errors.New("invalid reaction")
+	ErrReactionContentClosed = errors.New("content not found")
+)
+
+type ReactionService interface {
+	GetReactions(ctx context.Context, contentID int64, visitorHash string) (*entity.ReactionSummaryEntity, error)
+	AddReaction(ctx context.Context, req entity.ReactionEntity) (*entity.ReactionSummaryEntity, error)
+	RemoveReaction(ctx context.Context, req entity.ReactionEntity) (*entity.ReactionSummaryEntity, error)
+}
+
+type reactionService struct {
+	reactionRepository repository.ReactionRepository
+	contentRepository  repository.ContentRepository
+}
+
+// AddReaction implements ReactionService.
+func (r *reactionService) AddReaction(ctx context.Context, req entity.ReactionEntity) (*entity.ReactionSummaryEntity, error) {
+	if err = r.validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	_, err = r.reactionRepository.AddReaction(ctx, req)
+	if err != nil {
+		code = "[SERVICE] AddReaction - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return r.summary(ctx, req.ContentID, req.VisitorHash)
+}
+
+// RemoveReaction implements ReactionService.
+func (r *reactionService) RemoveReaction(ctx context.Context, req entity.ReactionEntity) (*entity.ReactionSummaryEntity, error) {
+	if err = r.validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	_, err = r.reactionRepository.RemoveReaction(ctx, req)
+	if err != nil {
+		code = "[SERVICE] RemoveReaction - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return r.summary(ctx, req.ContentID, req.VisitorHash)
+}
+
+// GetReactions implements ReactionService.
+func (r *reactionService) GetReactions(ctx context.Context, contentID int64, visitorHash string) (*entity.ReactionSummaryEntity, error) {
+	content, err := r.contentRepository.GetContentByID(ctx, contentID)
+	if err != nil || content.Status != "PUBLISH" {
+		code = "[SERVICE] GetReactions - 1"
+		log.Errorw(code, err)
+		return nil, ErrReactionContentClosed
+	}
+
+	return r.summary(ctx, contentID, visitorHash)
+}
+
+func (r *reactionService) validate(ctx context.Context, req entity.ReactionEntity) error {
+	if !slices.Contains(entity.ReactionTypes, req.Reaction) {
+		return ErrReactionInvalid
+	}
+
+	content, err := r.contentRepository.GetContentByID(ctx, req.ContentID)
+	if err != nil || content.Status != "PUBLISH" {
+		code = "[SERVICE] ValidateReaction - 1"
+		log.Errorw(code, err)
+		return ErrReactionContentClosed
+	}
+
+	return nil
+}
+
+func (r *reactionService) summary(ctx context.Context, contentID int64, visitorHash string) (*entity.ReactionSummaryEntity, error) {
+	counts, err := r.reactionRepository.GetReactionCounts(ctx, []int64{contentID})
+	if err != nil {
+		code = "[SERVICE] ReactionSummary - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	mine, err := r.reactionRepository.GetVisitorReactions(ctx, contentID, visitorHash)
+	if err != nil {
+		code = "[SERVICE] ReactionSummary - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	resp := entity.ReactionSummaryEntity{
+		ContentID: contentID,
+		Counts:    counts[contentID],
+		Mine:      mine,
+	}
+
+	for _, total := range resp.Counts {
+		resp.Total += total
+	}
+
+	return &resp, nil
+}
+
+func NewReactionService(reactionRepo repository.ReactionRepository, contentRepo repository.ContentRepository) ReactionService {
+	return &reactionService{
+		reactionRepository: reactionRepo,
+		contentRepository:  contentRepo,
+	}
+}
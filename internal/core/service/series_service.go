@@ -0,0 +1,176 @@
+package service
+
+import (
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/conv"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+var (
+	ErrSeriesDuplicatePart = errors.New("a content can only appear once in a series")
+	ErrSeriesInvalidPart   = errors.New("series part does not exist")
+)
+
+type SeriesService interface {
+	GetSeries(ctx context.Context) ([]entity.SeriesEntity, error)
+	GetSeriesByID(ctx context.Context, id int64) (*entity.SeriesEntity, error)
+	CreateSeries(ctx context.Context, req entity.SeriesEntity) (int64, error)
+	EditSeriesByID(ctx context.Context, req entity.SeriesEntity) error
+	DeleteSeries(ctx context.Context, id int64) error
+	SetSeriesContents(ctx context.Context, seriesID int64, contentIDs []int64) error
+
+	GetSeriesFE(ctx context.Context) ([]entity.SeriesEntity, error)
+	GetSeriesBySlugFE(ctx context.Context, slug string) (*entity.SeriesEntity, error)
+}
+
+type seriesService struct {
+	seriesRepository  repository.SeriesRepository
+	contentRepository repository.ContentRepository
+}
+
+// GetSeries implements SeriesService.
+func (s *seriesService) GetSeries(ctx context.Context) ([]entity.SeriesEntity, error) {
+	results, err := s.seriesRepository.GetSeries(ctx, false)
+	if err != nil {
+		code = "[SERVICE] GetSeries - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetSeriesByID implements SeriesService.
+func (s *seriesService) GetSeriesByID(ctx context.Context, id int64) (*entity.SeriesEntity, error) {
+	result, err := s.seriesRepository.GetSeriesByID(ctx, id)
+	if err != nil {
+		code = "[SERVICE] GetSeriesByID - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CreateSeries implements SeriesService.
+func (s *seriesService) CreateSeries(ctx context.Context, req entity.SeriesEntity) (int64, error) {
+	req.Slug = conv.GenerateSlug(req.Title)
+
+	id, err := s.seriesRepository.CreateSeries(ctx, req)
+	if err != nil {
+		code = "[SERVICE] CreateSeries - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// EditSeriesByID implements SeriesService.
+func (s *seriesService) EditSeriesByID(ctx context.Context, req entity.SeriesEntity) error {
+	seriesData, err := s.seriesRepository.GetSeriesByID(ctx, req.ID)
+	if err != nil {
+		code = "[SERVICE] EditSeriesByID - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	slug := conv.GenerateSlug(req.Title)
+	if seriesData.Title == req.Title {
+		slug = seriesData.Slug
+	}
+
+	req.Slug = slug
+
+	err = s.seriesRepository.EditSeriesByID(ctx, req)
+	if err != nil {
+		code = "[SERVICE] EditSeriesByID - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSeries implements SeriesService.
+func (s *seriesService) DeleteSeries(ctx context.Context, id int64) error {
+	err = s.seriesRepository.DeleteSeries(ctx, id)
+	if err != nil {
+		code = "[SERVICE] DeleteSeries - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// SetSeriesContents implements SeriesService.
+func (s *seriesService) SetSeriesContents(ctx context.Context, seriesID int64, contentIDs []int64) error {
+	_, err := s.seriesRepository.GetSeriesByID(ctx, seriesID)
+	if err != nil {
+		code = "[SERVICE] SetSeriesContents - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	seen := map[int64]bool{}
+	for _, contentID := range contentIDs {
+		if seen[contentID] {
+			return ErrSeriesDuplicatePart
+		}
+		seen[contentID] = true
+
+		_, err = s.contentRepository.GetContentByID(ctx, contentID)
+		if err != nil {
+			code = "[SERVICE] SetSeriesContents - 2"
+			log.Errorw(code, err)
+			return fmt.Errorf("%w: %d", ErrSeriesInvalidPart, contentID)
+		}
+	}
+
+	err = s.seriesRepository.SetSeriesContents(ctx, seriesID, contentIDs)
+	if err != nil {
+		code = "[SERVICE] SetSeriesContents - 3"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetSeriesFE implements SeriesService. Series without a published part
+// are left out.
+func (s *seriesService) GetSeriesFE(ctx context.Context) ([]entity.SeriesEntity, error) {
+	results, err := s.seriesRepository.GetSeries(ctx, true)
+	if err != nil {
+		code = "[SERVICE] GetSeriesFE - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetSeriesBySlugFE implements SeriesService.
+func (s *seriesService) GetSeriesBySlugFE(ctx context.Context, slug string) (*entity.SeriesEntity, error) {
+	result, err := s.seriesRepository.GetSeriesBySlug(ctx, slug)
+	if err != nil {
+		code = "[SERVICE] GetSeriesBySlugFE - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func NewSeriesService(seriesRepo repository.SeriesRepository, contentRepo repository.ContentRepository) SeriesService {
+	return &seriesService{
+		seriesRepository:  seriesRepo,
+		contentRepository: contentRepo,
+	}
+}
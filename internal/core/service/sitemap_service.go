@@ -0,0 +1,244 @@
+package service
+
+import (
+	"blog/config"
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/cache"
+	"blog/lib/permalink"
+	"blog/lib/sitemap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	SitemapContents   = "contents"
+	SitemapCategories = "categories"
+	SitemapTags       = "tags"
+
+	sitemapPageSize    = 5000
+	sitemapCacheTTL    = time.Hour
+	sitemapCachePrefix = "sitemap:"
+)
+
+var ErrSitemapNotFound = errors.New("sitemap not found")
+
+type SitemapService interface {
+	GetSitemapList(ctx context.Context) ([]entity.SitemapEntity, error)
+	GetSitemapIndex(ctx context.Context) ([]byte, error)
+	GetSitemap(ctx context.Context, kind string, page int) ([]byte, error)
+}
+
+type sitemapService struct {
+	sitemapRepository repository.SitemapRepository
+	cache             cache.Cache
+	cfg               *config.Config
+}
+
+type sitemapPage struct {
+	body    []byte
+	lastMod time.Time
+}
+
+// SitemapFileName returns the file name a child sitemap is served under.
+func SitemapFileName(kind string, page int) string {
+	return fmt.Sprintf("sitemap-%s-%d.xml", kind, page)
+}
+
+// GetSitemapList implements SitemapService.
+func (s *sitemapService) GetSitemapList(ctx context.Context) ([]entity.SitemapEntity, error) {
+	countContents, err := s.sitemapRepository.CountContents(ctx, true)
+	if err != nil {
+		code = "[SERVICE] GetSitemapList - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	categories, err := s.loadItems(ctx, SitemapCategories)
+	if err != nil {
+		code = "[SERVICE] GetSitemapList - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	tags, err := s.loadItems(ctx, SitemapTags)
+	if err != nil {
+		code = "[SERVICE] GetSitemapList - 3"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	totals := []struct {
+		kind  string
+		count int
+	}{
+		{SitemapContents, int(countContents)},
+		{SitemapCategories, len(categories)},
+		{SitemapTags, len(tags)},
+	}
+
+	var resps []entity.SitemapEntity
+	for _, total := range totals {
+		pages := int(math.Ceil(float64(total.count) / float64(sitemapPageSize)))
+		for page := 1; page <= pages; page++ {
+			result, err := s.getPage(ctx, total.kind, page)
+			if err != nil {
+				code = "[SERVICE] GetSitemapList - 4"
+				log.Errorw(code, err)
+				return nil, err
+			}
+
+			resps = append(resps, entity.SitemapEntity{
+				Kind:    total.kind,
+				Page:    page,
+				LastMod: result.lastMod,
+			})
+		}
+	}
+
+	return resps, nil
+}
+
+// GetSitemapIndex implements SitemapService.
+func (s *sitemapService) GetSitemapIndex(ctx context.Context) ([]byte, error) {
+	if cached, ok := s.cache.Get(sitemapCachePrefix + "index"); ok {
+		return cached.([]byte), nil
+	}
+
+	results, err := s.GetSitemapList(ctx)
+	if err != nil {
+		code = "[SERVICE] GetSitemapIndex - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	baseUrl := strings.TrimRight(s.cfg.App.PublicUrl, "/")
+	var sitemaps []sitemap.Sitemap
+	for _, result := range results {
+		sitemaps = append(sitemaps, sitemap.Sitemap{
+			Loc:     baseUrl + "/" + SitemapFileName(result.Kind, result.Page),
+			LastMod: result.LastMod,
+		})
+	}
+
+	body, err := sitemap.Index(sitemaps)
+	if err != nil {
+		code = "[SERVICE] GetSitemapIndex - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	s.cache.Set(sitemapCachePrefix+"index", body, sitemapCacheTTL)
+
+	return body, nil
+}
+
+// GetSitemap implements SitemapService.
+func (s *sitemapService) GetSitemap(ctx context.Context, kind string, page int) ([]byte, error) {
+	result, err := s.getPage(ctx, kind, page)
+	if err != nil {
+		code = "[SERVICE] GetSitemap - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return result.body, nil
+}
+
+func (s *sitemapService) getPage(ctx context.Context, kind string, page int) (*sitemapPage, error) {
+	key := fmt.Sprintf("%s%s:%d", sitemapCachePrefix, kind, page)
+	if cached, ok := s.cache.Get(key); ok {
+		return cached.(*sitemapPage), nil
+	}
+
+	if page < 1 {
+		return nil, ErrSitemapNotFound
+	}
+
+	var err error
+	var items []entity.SitemapItemEntity
+	var toLoc func(baseUrl, slug string) string
+
+	switch kind {
+	case SitemapContents:
+		items, err = s.sitemapRepository.GetContents(ctx, (page-1)*sitemapPageSize, sitemapPageSize, true)
+		toLoc = permalink.Content
+	case SitemapCategories, SitemapTags:
+		items, err = s.loadItems(ctx, kind)
+		if start := (page - 1) * sitemapPageSize; start < len(items) {
+			items = items[start:min(start+sitemapPageSize, len(items))]
+		} else {
+			items = nil
+		}
+
+		toLoc = permalink.Category
+		if kind == SitemapTags {
+			toLoc = permalink.Tag
+		}
+	default:
+		return nil, ErrSitemapNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, ErrSitemapNotFound
+	}
+
+	result := sitemapPage{}
+	var urls []sitemap.Url
+	for _, item := range items {
+		u := sitemap.Url{
+			Loc:     toLoc(s.cfg.App.PublicUrl, item.Slug),
+			LastMod: item.UpdatedAt,
+		}
+
+		if kind == SitemapContents && item.Image != "" {
+			u.Images = []string{item.Image}
+		}
+
+		if item.UpdatedAt.After(result.lastMod) {
+			result.lastMod = item.UpdatedAt
+		}
+
+		urls = append(urls, u)
+	}
+
+	result.body, err = sitemap.UrlSet(urls)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(key, &result, sitemapCacheTTL)
+
+	return &result, nil
+}
+
+func (s *sitemapService) loadItems(ctx context.Context, kind string) ([]entity.SitemapItemEntity, error) {
+	if kind == SitemapTags {
+		return s.sitemapRepository.GetTags(ctx)
+	}
+
+	return s.sitemapRepository.GetCategories(ctx)
+}
+
+// InvalidateSitemaps drops every cached sitemap so the next request rebuilds them.
+func InvalidateSitemaps(c cache.Cache) {
+	c.DeletePrefix(sitemapCachePrefix)
+}
+
+func NewSitemapService(sitemapRepo repository.SitemapRepository, cache cache.Cache, cfg *config.Config) SitemapService {
+	return &sitemapService{
+		sitemapRepository: sitemapRepo,
+		cache:             cache,
+		cfg:               cfg,
+	}
+}
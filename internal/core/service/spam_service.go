@@ -0,0 +1,231 @@
+package service
+
+import (
+	"blog/config"
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/cache"
+	"blog/lib/spam"
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	spamBlocklistCacheKey = "spam:blocklist"
+	spamBlocklistCacheTTL = 5 * time.Minute
+)
+
+type SpamService interface {
+	Check(ctx context.Context, sub spam.Submission) spam.Verdict
+	IssueToken() string
+	Train(ctx context.Context, comments []entity.CommentEntity) (int64, error)
+
+	GetBlocklist(ctx context.Context) ([]entity.SpamBlocklistEntity, error)
+	CreateBlocklist(ctx context.Context, req entity.SpamBlocklistEntity) error
+	DeleteBlocklist(ctx context.Context, id int64) error
+}
+
+type spamService struct {
+	spamRepository repository.SpamRepository
+	cache          cache.Cache
+	pipeline       spam.Pipeline
+	timing         spam.Timing
+	ownHosts       []string
+}
+
+// Check implements SpamService. A failing check is logged and ignored so
+// an outage never blocks legitimate submissions.
+func (s *spamService) Check(ctx context.Context, sub spam.Submission) spam.Verdict {
+	verdict, err := s.pipeline.Check(ctx, sub)
+	if err != nil {
+		code = "[SERVICE] SpamCheck - 1"
+		log.Errorw(code, err)
+	}
+
+	return verdict
+}
+
+// IssueToken implements SpamService.
+func (s *spamService) IssueToken() string {
+	return s.timing.Issue(time.Now())
+}
+
+// Train implements SpamService. Every domain linked from the given comments,
+// other than our own, is added to the blocklist.
+func (s *spamService) Train(ctx context.Context, comments []entity.CommentEntity) (int64, error) {
+	entries := []entity.SpamBlocklistEntity{}
+	seen := map[string]bool{}
+
+	for _, comment := range comments {
+		for _, domain := range spam.Domains(comment.Body + " " + comment.Name) {
+			if seen[domain] || s.isOwnHost(domain) {
+				continue
+			}
+
+			seen[domain] = true
+			entries = append(entries, entity.SpamBlocklistEntity{
+				Kind:   entity.SpamBlocklistDomain,
+				Value:  domain,
+				Source: entity.SpamSourceTrained,
+			})
+		}
+	}
+
+	added, err := s.spamRepository.CreateBlocklist(ctx, entries)
+	if err != nil {
+		code = "[SERVICE] Train - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	s.cache.Delete(spamBlocklistCacheKey)
+
+	return added, nil
+}
+
+func (s *spamService) isOwnHost(domain string) bool {
+	for _, host := range s.ownHosts {
+		if domain == host || strings.HasSuffix(domain, "."+host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Blocklist implements spam.BlocklistSource.
+func (s *spamService) Blocklist(ctx context.Context) ([]string, []string, error) {
+	results, err := s.GetBlocklist(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var words, domains []string
+	for _, result := range results {
+		switch result.Kind {
+		case entity.SpamBlocklistWord:
+			words = append(words, result.Value)
+		case entity.SpamBlocklistDomain:
+			domains = append(domains, result.Value)
+		}
+	}
+
+	return words, domains, nil
+}
+
+// GetBlocklist implements SpamService.
+func (s *spamService) GetBlocklist(ctx context.Context) ([]entity.SpamBlocklistEntity, error) {
+	if cached, ok := s.cache.Get(spamBlocklistCacheKey); ok {
+		return cached.([]entity.SpamBlocklistEntity), nil
+	}
+
+	results, err := s.spamRepository.GetBlocklist(ctx)
+	if err != nil {
+		code = "[SERVICE] GetBlocklist - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	s.cache.Set(spamBlocklistCacheKey, results, spamBlocklistCacheTTL)
+
+	return results, nil
+}
+
+// CreateBlocklist implements SpamService.
+func (s *spamService) CreateBlocklist(ctx context.Context, req entity.SpamBlocklistEntity) error {
+	req.Value = strings.ToLower(strings.TrimSpace(req.Value))
+	if req.Kind == entity.SpamBlocklistDomain {
+		req.Value = strings.TrimPrefix(req.Value, "www.")
+	}
+	req.Source = entity.SpamSourceManual
+
+	_, err = s.spamRepository.CreateBlocklist(ctx, []entity.SpamBlocklistEntity{req})
+	if err != nil {
+		code = "[SERVICE] CreateBlocklist - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	s.cache.Delete(spamBlocklistCacheKey)
+
+	return nil
+}
+
+// DeleteBlocklist implements SpamService.
+func (s *spamService) DeleteBlocklist(ctx context.Context, id int64) error {
+	err = s.spamRepository.DeleteBlocklist(ctx, id)
+	if err != nil {
+		code = "[SERVICE] DeleteBlocklist - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	s.cache.Delete(spamBlocklistCacheKey)
+
+	return nil
+}
+
+func hostOf(rawUrl string) string {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
+
+func NewSpamService(spamRepo repository.SpamRepository, commentRepo repository.CommentRepository, cache cache.Cache, cfg *config.Config) SpamService {
+	secret := cfg.Spam.Secret
+	if secret == "" {
+		secret = cfg.App.JwtSecretKey
+	}
+
+	svc := &spamService{
+		spamRepository: spamRepo,
+		cache:          cache,
+		timing: spam.Timing{
+			Secret:   []byte(secret),
+			MinDelay: time.Duration(cfg.Spam.TimingMinSeconds) * time.Second,
+			MaxAge:   time.Duration(cfg.Spam.TimingMaxAge) * time.Second,
+		},
+	}
+
+	for _, rawUrl := range []string{cfg.App.PublicUrl, cfg.R2.PublicUrl} {
+		if host := hostOf(rawUrl); host != "" {
+			svc.ownHosts = append(svc.ownHosts, host)
+		}
+	}
+
+	checkers := []spam.Checker{}
+	if cfg.Spam.LinkEnabled {
+		checkers = append(checkers, spam.LinkDensity{MaxLinks: cfg.Spam.MaxLinks, MaxRatio: 0.2})
+	}
+
+	if cfg.Spam.BlocklistEnabled {
+		checkers = append(checkers, spam.Blocklist{Source: svc})
+	}
+
+	if cfg.Spam.HoneypotEnabled {
+		checkers = append(checkers, spam.Honeypot{})
+	}
+
+	if cfg.Spam.TimingEnabled {
+		checkers = append(checkers, svc.timing)
+	}
+
+	if cfg.Spam.RepeatedEnabled {
+		checkers = append(checkers, spam.Repeated{
+			Store:      commentRepo,
+			Window:     time.Duration(cfg.Spam.RepeatedWindow) * time.Second,
+			MaxRepeats: cfg.Spam.RepeatedMaxRepeats,
+		})
+	}
+
+	svc.pipeline = spam.NewPipeline(cfg.Spam.Threshold, checkers...)
+
+	return svc
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type TemplateService interface {
+	GetTemplates(ctx context.Context) ([]entity.ContentTemplateEntity, error)
+	GetTemplateByID(ctx context.Context, id int64) (*entity.ContentTemplateEntity, error)
+	CreateTemplate(ctx context.Context, req entity.ContentTemplateEntity) (int64, error)
+	EditTemplateByID(ctx context.Context, req entity.ContentTemplateEntity) error
+	DeleteTemplate(ctx context.Context, id int64) error
+}
+
+var ErrTemplateNotFound = errors.New("template not found")
+
+type templateService struct {
+	templateRepository repository.TemplateRepository
+}
+
+// expandTitlePattern fills the date placeholders of a title pattern.
+func expandTitlePattern(pattern string, now time.Time) string {
+	_, week := now.ISOWeek()
+
+	return strings.NewReplacer(
+		"{date}", now.Format("2006-01-02"),
+		"{year}", strconv.Itoa(now.Year()),
+		"{month}", now.Format("January"),
+		"{week}", strconv.Itoa(week),
+		"{day}", strconv.Itoa(now.Day()),
+	).Replace(pattern)
+}
+
+// applyTemplate fills what req leaves empty from a template: the title from
+// its pattern, the body skeleton, the category and the tags.
+func applyTemplate(req *entity.ContentEntity, template entity.ContentTemplateEntity, now time.Time) {
+	if req.Title == "" {
+		req.Title = expandTitlePattern(template.TitlePattern, now)
+	}
+	if req.Description == "" {
+		req.Description = template.Description
+	}
+	if req.CategoryID == 0 {
+		req.CategoryID = template.CategoryID
+	}
+	if len(req.Tags) == 0 || (len(req.Tags) == 1 && strings.TrimSpace(req.Tags[0]) == "") {
+		req.Tags = template.Tags
+	}
+}
+
+// GetTemplates implements TemplateService.
+func (t *templateService) GetTemplates(ctx context.Context) ([]entity.ContentTemplateEntity, error) {
+	results, err := t.templateRepository.GetTemplates(ctx)
+	if err != nil {
+		code = "[SERVICE] GetTemplates - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetTemplateByID implements TemplateService.
+func (t *templateService) GetTemplateByID(ctx context.Context, id int64) (*entity.ContentTemplateEntity, error) {
+	result, err := t.templateRepository.GetTemplateByID(ctx, id)
+	if err != nil {
+		code = "[SERVICE] GetTemplateByID - 1"
+		log.Errorw(code, err)
+		return nil, ErrTemplateNotFound
+	}
+
+	return result, nil
+}
+
+// CreateTemplate implements TemplateService.
+func (t *templateService) CreateTemplate(ctx context.Context, req entity.ContentTemplateEntity) (int64, error) {
+	id, err := t.templateRepository.CreateTemplate(ctx, req)
+	if err != nil {
+		code = "[SERVICE] CreateTemplate - 1"
+		log.Errorw(code, err)
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// EditTemplateByID implements TemplateService.
+func (t *templateService) EditTemplateByID(ctx context.Context, req entity.ContentTemplateEntity) error {
+	_, err := t.templateRepository.GetTemplateByID(ctx, req.ID)
+	if err != nil {
+		code = "[SERVICE] EditTemplateByID - 1"
+		log.Errorw(code, err)
+		return ErrTemplateNotFound
+	}
+
+	err = t.templateRepository.EditTemplateByID(ctx, req)
+	if err != nil {
+		code = "[SERVICE] EditTemplateByID - 2"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTemplate implements TemplateService. Contents created from the
+// template are not affected.
+func (t *templateService) DeleteTemplate(ctx context.Context, id int64) error {
+	err = t.templateRepository.DeleteTemplate(ctx, id)
+	if err != nil {
+		code = "[SERVICE] DeleteTemplate - 1"
+		log.Errorw(code, err)
+		return err
+	}
+
+	return nil
+}
+
+func NewTemplateService(templateRepo repository.TemplateRepository) TemplateService {
+	return &templateService{
+		templateRepository: templateRepo,
+	}
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/locale"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type TranslationService interface {
+	GetMissingTranslations(ctx context.Context, lang string) (*entity.MissingTranslationsEntity, error)
+}
+
+var (
+	ErrLocaleNotSupported        = errors.New("locale is not supported")
+	ErrTranslationSourceNotFound = errors.New("translation source not found")
+	ErrTranslationExists         = errors.New("a translation in this locale already exists")
+)
+
+type translationService struct {
+	translationRepository repository.TranslationRepository
+	locales               locale.Locales
+}
+
+// resolveLocale normalizes lang, falling back to current and then to the
+// default locale when it is empty.
+func resolveLocale(locales locale.Locales, lang, current string) (string, error) {
+	lang = locales.Normalize(lang)
+	if lang == "" {
+		lang = current
+	}
+	if lang == "" {
+		lang = locales.Default()
+	}
+
+	if !locales.IsSupported(lang) {
+		return "", ErrLocaleNotSupported
+	}
+
+	return lang, nil
+}
+
+// checkTranslationSlot fails when a row other than id already holds lang
+// among siblings.
+func checkTranslationSlot(siblings []entity.TranslationEntity, id int64, lang string) error {
+	for _, sibling := range siblings {
+		if sibling.ID != id && sibling.Locale == lang {
+			return ErrTranslationExists
+		}
+	}
+
+	return nil
+}
+
+// missingLocales lists the supported locales a group does not exist in.
+func missingLocales(supported, locales []string) []string {
+	have := map[string]bool{}
+	for _, lang := range locales {
+		have[lang] = true
+	}
+
+	missing := []string{}
+	for _, lang := range supported {
+		if !have[lang] {
+			missing = append(missing, lang)
+		}
+	}
+
+	return missing
+}
+
+// filterMissing keeps the groups lacking a supported locale, or lacking
+// lang when it is set.
+func (t *translationService) filterMissing(groups []entity.TranslationGroupEntity, lang string) []entity.TranslationGroupEntity {
+	resps := []entity.TranslationGroupEntity{}
+	for _, group := range groups {
+		group.Missing = missingLocales(t.locales.Supported(), group.Locales)
+		if len(group.Missing) == 0 {
+			continue
+		}
+
+		if lang != "" {
+			found := false
+			for _, missing := range group.Missing {
+				if missing == lang {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		resps = append(resps, group)
+	}
+
+	return resps
+}
+
+// GetMissingTranslations implements TranslationService.
+func (t *translationService) GetMissingTranslations(ctx context.Context, lang string) (*entity.MissingTranslationsEntity, error) {
+	if lang != "" {
+		lang = t.locales.Normalize(lang)
+		if !t.locales.IsSupported(lang) {
+			return nil, ErrLocaleNotSupported
+		}
+	}
+
+	contentGroups, err := t.translationRepository.GetContentGroups(ctx)
+	if err != nil {
+		code = "[SERVICE] GetMissingTranslations - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	categoryGroups, err := t.translationRepository.GetCategoryGroups(ctx)
+	if err != nil {
+		code = "[SERVICE] GetMissingTranslations - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return &entity.MissingTranslationsEntity{
+		Contents:   t.filterMissing(contentGroups, lang),
+		Categories: t.filterMissing(categoryGroups, lang),
+	}, nil
+}
+
+func NewTranslationService(translationRepo repository.TranslationRepository, locales locale.Locales) TranslationService {
+	return &translationService{
+		translationRepository: translationRepo,
+		locales:               locales,
+	}
+}
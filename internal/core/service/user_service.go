@@ -1,6 +1,7 @@
 package service
 
 import (
+	"blog/internal/adapter/cloudflare"
 	"blog/internal/adapter/repository"
 	"blog/internal/core/domain/entity"
 	"blog/lib/conv"
@@ -12,10 +13,14 @@ import (
 type UserService interface {
 	UpdatePassword(ctx context.Context, newPass string, id int64) error
 	GetUserById(ctx context.Context, id int64) (*entity.UserEntity, error)
+	GetUserBySlug(ctx context.Context, slug string) (*entity.UserEntity, error)
+	UpdateProfile(ctx context.Context, req entity.UserEntity) (*entity.UserEntity, error)
+	UploadAvatar(ctx context.Context, id int64, req entity.FileUploadEntity) (string, error)
 }
 
 type userService struct {
 	userRepository repository.UserRepository
+	r2             cloudflare.CloudFlareR2Adapter
 }
 
 // GetUserById implements UserService.
@@ -30,6 +35,70 @@ func (u *userService) GetUserById(ctx context.Context, id int64) (*entity.UserEn
 	return result, nil
 }
 
+// GetUserBySlug implements UserService.
+func (u *userService) GetUserBySlug(ctx context.Context, slug string) (*entity.UserEntity, error) {
+	result, err := u.userRepository.GetUserBySlug(ctx, slug)
+	if err != nil {
+		code := "[SERVICE] GetUserBySlug - 1"
+		log.Errorw(code, err)
+		return nil, entity.ErrAuthorNotFound
+	}
+
+	return result, nil
+}
+
+// UpdateProfile implements UserService. Without a slug the current one is
+// kept, or one is made from the display name, or the name, for users that
+// have none.
+func (u *userService) UpdateProfile(ctx context.Context, req entity.UserEntity) (*entity.UserEntity, error) {
+	userData, err := u.userRepository.GetUserById(ctx, req.ID)
+	if err != nil {
+		code := "[SERVICE] UpdateProfile - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	switch {
+	case req.Slug != "":
+		req.Slug = conv.GenerateSlug(req.Slug)
+	case userData.Slug != "":
+		req.Slug = userData.Slug
+	case req.DisplayName != "":
+		req.Slug = conv.GenerateSlug(req.DisplayName)
+	default:
+		req.Slug = conv.GenerateSlug(req.Name)
+	}
+
+	err = u.userRepository.UpdateProfile(ctx, req)
+	if err != nil {
+		code := "[SERVICE] UpdateProfile - 2"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return u.userRepository.GetUserById(ctx, req.ID)
+}
+
+// UploadAvatar implements UserService. The uploaded image replaces the
+// user's avatar and its URL is returned.
+func (u *userService) UploadAvatar(ctx context.Context, id int64, req entity.FileUploadEntity) (string, error) {
+	urlImage, err := u.r2.UploadImage(&req)
+	if err != nil {
+		code := "[SERVICE] UploadAvatar - 1"
+		log.Errorw(code, err)
+		return "", err
+	}
+
+	err = u.userRepository.UpdateAvatar(ctx, urlImage, id)
+	if err != nil {
+		code := "[SERVICE] UploadAvatar - 2"
+		log.Errorw(code, err)
+		return "", err
+	}
+
+	return urlImage, nil
+}
+
 // UpdatePassword implements UserService.
 func (u *userService) UpdatePassword(ctx context.Context, newPass string, id int64) error {
 	password, err := conv.HashPassword(newPass)
@@ -49,8 +118,9 @@ func (u *userService) UpdatePassword(ctx context.Context, newPass string, id int
 	return nil
 }
 
-func NewUserService(userRepository repository.UserRepository) UserService {
+func NewUserService(userRepository repository.UserRepository, r2 cloudflare.CloudFlareR2Adapter) UserService {
 	return &userService{
 		userRepository: userRepository,
+		r2:             r2,
 	}
 }
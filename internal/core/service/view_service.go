@@ -0,0 +1,201 @@
+package service
+
+import (
+	"blog/internal/adapter/repository"
+	"blog/internal/core/domain/entity"
+	"blog/lib/cache"
+	"blog/lib/visitor"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	// viewDedupeWindow is how long repeated reads of a post by the same
+	// visitor count as a single view.
+	viewDedupeWindow = 30 * time.Minute
+	popularCacheTTL  = 5 * time.Minute
+	popularCacheKey  = "popular:"
+)
+
+type ViewService interface {
+	Track(contentID int64, visitorHash string, userAgent string)
+	Run(ctx context.Context, interval time.Duration)
+	Flush(ctx context.Context) error
+
+	GetPopularContents(ctx context.Context, days int, limit int) ([]entity.PopularContentEntity, error)
+	GetContentViews(ctx context.Context, contentID int64, days int) (*entity.ViewStatsEntity, error)
+}
+
+type viewKey struct {
+	contentID int64
+	day       string
+}
+
+type viewCount struct {
+	views       int64
+	uniqueViews int64
+}
+
+type viewService struct {
+	viewRepository repository.ViewRepository
+	cache          cache.Cache
+
+	mu      sync.Mutex
+	pending map[viewKey]*viewCount
+	seen    map[string]time.Time
+}
+
+// Track implements ViewService. Views are only counted in memory here and
+// written by Flush, so reading a post never waits on a database write.
+func (v *viewService) Track(contentID int64, visitorHash string, userAgent string) {
+	if visitor.IsBot(userAgent) {
+		return
+	}
+
+	now := time.Now()
+	day := now.Format(time.DateOnly)
+	seenKey := fmt.Sprintf("%d:%s", contentID, visitorHash)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	lastSeen, ok := v.seen[seenKey]
+	if ok && now.Sub(lastSeen) < viewDedupeWindow {
+		return
+	}
+	v.seen[seenKey] = now
+
+	key := viewKey{contentID: contentID, day: day}
+	count, exists := v.pending[key]
+	if !exists {
+		count = &viewCount{}
+		v.pending[key] = count
+	}
+
+	count.views++
+	if !ok || lastSeen.Format(time.DateOnly) != day {
+		count.uniqueViews++
+	}
+}
+
+// Run implements ViewService. It flushes pending views every interval
+// until ctx is cancelled.
+func (v *viewService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = v.Flush(ctx)
+		}
+	}
+}
+
+// Flush implements ViewService. Pending views are put back if the write
+// fails so they are retried on the next flush.
+func (v *viewService) Flush(ctx context.Context) error {
+	v.mu.Lock()
+	pending := v.pending
+	v.pending = make(map[viewKey]*viewCount)
+
+	cutoff := time.Now().Add(-24 * time.Hour)
+	for key, lastSeen := range v.seen {
+		if lastSeen.Before(cutoff) {
+			delete(v.seen, key)
+		}
+	}
+	v.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	req := []entity.ViewDailyEntity{}
+	for key, count := range pending {
+		day, _ := time.Parse(time.DateOnly, key.day)
+		req = append(req, entity.ViewDailyEntity{
+			ContentID:   key.contentID,
+			Day:         day,
+			Views:       count.views,
+			UniqueViews: count.uniqueViews,
+		})
+	}
+
+	err := v.viewRepository.SaveViews(ctx, req)
+	if err != nil {
+		code = "[SERVICE] FlushViews - 1"
+		log.Errorw(code, err)
+
+		v.mu.Lock()
+		for key, count := range pending {
+			current, ok := v.pending[key]
+			if !ok {
+				v.pending[key] = count
+				continue
+			}
+			current.views += count.views
+			current.uniqueViews += count.uniqueViews
+		}
+		v.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// GetPopularContents implements ViewService.
+func (v *viewService) GetPopularContents(ctx context.Context, days int, limit int) ([]entity.PopularContentEntity, error) {
+	cacheKey := fmt.Sprintf("%s%d:%d", popularCacheKey, days, limit)
+	if cached, ok := v.cache.Get(cacheKey); ok {
+		return cached.([]entity.PopularContentEntity), nil
+	}
+
+	results, err := v.viewRepository.GetPopularContents(ctx, windowStart(days), limit)
+	if err != nil {
+		code = "[SERVICE] GetPopularContents - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	v.cache.Set(cacheKey, results, popularCacheTTL)
+
+	return results, nil
+}
+
+// GetContentViews implements ViewService.
+func (v *viewService) GetContentViews(ctx context.Context, contentID int64, days int) (*entity.ViewStatsEntity, error) {
+	result, err := v.viewRepository.GetContentViews(ctx, contentID, windowStart(days))
+	if err != nil {
+		code = "[SERVICE] GetContentViews - 1"
+		log.Errorw(code, err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// windowStart returns the first day of a window of the given number of days
+// ending today.
+func windowStart(days int) time.Time {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	return today.AddDate(0, 0, -(days - 1))
+}
+
+func NewViewService(viewRepo repository.ViewRepository, cache cache.Cache) ViewService {
+	return &viewService{
+		viewRepository: viewRepo,
+		cache:          cache,
+		pending:        make(map[viewKey]*viewCount),
+		seen:           make(map[string]time.Time),
+	}
+}
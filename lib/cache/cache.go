@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+type Cache interface {
+	Get(key string) (interface{}, bool)
+	Set(key string, value interface{}, ttl time.Duration)
+	Delete(key string)
+	DeletePrefix(prefix string)
+}
+
+type item struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+type Options struct {
+	mu    sync.RWMutex
+	items map[string]item
+}
+
+// Get implements Cache.
+func (o *Options) Get(key string) (interface{}, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	it, ok := o.items[key]
+	if !ok || time.Now().After(it.expiresAt) {
+		return nil, false
+	}
+
+	return it.value, true
+}
+
+// Set implements Cache.
+func (o *Options) Set(key string, value interface{}, ttl time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.items[key] = item{
+		value:     value,
+		expiresAt: time.Now().Add(ttl),
+	}
+}
+
+// Delete implements Cache.
+func (o *Options) Delete(key string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.items, key)
+}
+
+// DeletePrefix implements Cache.
+func (o *Options) DeletePrefix(prefix string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for key := range o.items {
+		if strings.HasPrefix(key, prefix) {
+			delete(o.items, key)
+		}
+	}
+}
+
+// janitor drops expired items so keys that are never read again do not pile up.
+func (o *Options) janitor(interval time.Duration) {
+	for range time.Tick(interval) {
+		now := time.Now()
+
+		o.mu.Lock()
+		for key, it := range o.items {
+			if now.After(it.expiresAt) {
+				delete(o.items, key)
+			}
+		}
+		o.mu.Unlock()
+	}
+}
+
+func NewCache() Cache {
+	opt := new(Options)
+	opt.items = make(map[string]item)
+
+	go opt.janitor(time.Minute)
+
+	return opt
+}
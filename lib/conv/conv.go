@@ -2,7 +2,6 @@ package conv
 
 import (
 	"strconv"
-	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -19,13 +18,6 @@ func CheckPasswordHash(password, hash string) bool {
 	return err == nil
 }
 
-func GenerateSlug(title string) string {
-	slug := strings.ToLower(title)
-	slug = strings.ReplaceAll(slug, " ", "-")
-
-	return slug
-}
-
 func StringToInt64(str string) (int64, error) {
 	newData, err := strconv.ParseInt(str, 10, 64)
 
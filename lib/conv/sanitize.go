@@ -0,0 +1,191 @@
+package conv
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// allowedTags maps the elements SanitizeHTML keeps to the attributes they
+// may carry.
+var allowedTags = map[string][]string{
+	"a": {"href", "title", "rel"}, "img": {"src", "alt", "title", "width", "height"},
+	"p": nil, "br": nil, "hr": nil, "span": {"class"}, "div": {"class"},
+	"h1": {"id"}, "h2": {"id"}, "h3": {"id"}, "h4": {"id"}, "h5": {"id"}, "h6": {"id"},
+	"strong": nil, "b": nil, "em": nil, "i": nil, "u": nil, "s": nil, "del": nil, "ins": nil,
+	"sub": nil, "sup": nil, "mark": nil, "small": nil, "abbr": {"title"}, "cite": nil, "q": nil, "kbd": nil,
+	"blockquote": nil, "pre": {"class"}, "code": {"class"},
+	"ul": nil, "ol": {"start"}, "li": nil, "dl": nil, "dt": nil, "dd": nil,
+	"table": nil, "caption": nil, "thead": nil, "tbody": nil, "tfoot": nil, "tr": nil,
+	"th": {"colspan", "rowspan"}, "td": {"colspan", "rowspan"},
+	"figure": nil, "figcaption": nil,
+}
+
+// droppedTags are removed by SanitizeHTML together with everything inside.
+var droppedTags = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true, "noscript": true,
+	"template": true, "textarea": true, "select": true, "svg": true, "math": true, "title": true,
+}
+
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true}
+
+var urlAttrs = map[string]bool{"href": true, "src": true}
+
+var allowedSchemes = map[string]bool{"": true, "http": true, "https": true, "mailto": true}
+
+// StripTags removes every HTML tag from s and returns the unescaped text.
+// Contents of script and style elements are dropped entirely.
+func StripTags(s string) string {
+	var b strings.Builder
+	skip := 0
+
+	tokenizer := html.NewTokenizer(strings.NewReader(s))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return b.String()
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			if tag := string(name); tag == "script" || tag == "style" {
+				skip++
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			if tag := string(name); (tag == "script" || tag == "style") && skip > 0 {
+				skip--
+			}
+		case html.TextToken:
+			if skip == 0 {
+				b.Write(tokenizer.Text())
+			}
+		}
+	}
+}
+
+// SanitizeText strips markup from user submitted text, normalizes line
+// endings and collapses runs of blank lines.
+func SanitizeText(s string) string {
+	s = StripTags(s)
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, s)
+	s = blankLines.ReplaceAllString(s, "\n\n")
+
+	return strings.TrimSpace(s)
+}
+
+// SanitizeHTML keeps the markup of s that is allowed in a post body and
+// escapes everything else: unknown elements are unwrapped, scripts, styles
+// and embeds are dropped with their contents, event handlers and inline
+// styles are removed and links may only point to http, https and mailto
+// URLs. Open elements are closed so the result cannot leak into the page
+// around it.
+func SanitizeHTML(s string) string {
+	var b strings.Builder
+	open := []string{}
+	skip := 0
+
+	tokenizer := html.NewTokenizer(strings.NewReader(s))
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			for i := len(open) - 1; i >= 0; i-- {
+				b.WriteString("</" + open[i] + ">")
+			}
+			return b.String()
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if droppedTags[token.Data] {
+				if tokenType == html.StartTagToken {
+					skip++
+				}
+				continue
+			}
+
+			attrs, ok := allowedTags[token.Data]
+			if skip > 0 || !ok {
+				continue
+			}
+
+			b.WriteString("<" + token.Data)
+			for _, attr := range token.Attr {
+				if attr.Namespace != "" || !allowedAttr(attrs, attr) {
+					continue
+				}
+				b.WriteString(" " + attr.Key + `="` + html.EscapeString(attr.Val) + `"`)
+			}
+			b.WriteString(">")
+
+			if tokenType == html.StartTagToken && !voidTags[token.Data] {
+				open = append(open, token.Data)
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if droppedTags[tag] {
+				if skip > 0 {
+					skip--
+				}
+				continue
+			}
+
+			if skip > 0 {
+				continue
+			}
+
+			// close what is still open inside tag, stray end tags are dropped
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != tag {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					b.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		case html.TextToken:
+			if skip == 0 {
+				b.WriteString(html.EscapeString(string(tokenizer.Text())))
+			}
+		}
+	}
+}
+
+func allowedAttr(allowed []string, attr html.Attribute) bool {
+	if !slices.Contains(allowed, attr.Key) {
+		return false
+	}
+
+	if !urlAttrs[attr.Key] {
+		return true
+	}
+
+	// browsers ignore whitespace and control characters in the scheme
+	cleaned := strings.Map(func(r rune) rune {
+		if r <= 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, attr.Val)
+
+	u, err := url.Parse(cleaned)
+	if err != nil {
+		return false
+	}
+
+	return allowedSchemes[strings.ToLower(u.Scheme)]
+}
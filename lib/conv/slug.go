@@ -0,0 +1,157 @@
+package conv
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// SlugMaxLength is the maximum number of characters a generated slug may have.
+const SlugMaxLength = 80
+
+const slugFallback = "untitled"
+
+// reservedSlugs collide with fixed routes of the API and the public site.
+var reservedSlugs = map[string]bool{
+	"admin":        true,
+	"api":          true,
+	"atom":         true,
+	"create":       true,
+	"edit":         true,
+	"fe":           true,
+	"feed":         true,
+	"login":        true,
+	"new":          true,
+	"rss":          true,
+	"search":       true,
+	"sitemap":      true,
+	"upload-image": true,
+}
+
+// transliterations maps runes that do not decompose into ASCII to their latin spelling.
+var transliterations = map[rune]string{
+	'&': " and ", '+': " plus ", '@': " at ", '%': " percent ",
+	'ß': "ss", 'æ': "ae", 'ø': "o", 'œ': "oe", 'đ': "d", 'ð': "d", 'þ': "th", 'ł': "l", 'ı': "i", 'ħ': "h",
+
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'ґ': "g", 'д': "d", 'е': "e", 'ё': "yo", 'є': "ye",
+	'ж': "zh", 'з': "z", 'и': "i", 'і': "i", 'ї': "yi", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h",
+	'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya",
+
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i",
+	'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s",
+	'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// GenerateSlug turns a title into a URL friendly slug. Accents are removed,
+// Cyrillic and Greek are transliterated, punctuation becomes a dash, dashes are
+// collapsed and the result is cut to SlugMaxLength on a word boundary. Letters
+// of other scripts are kept as they are, since they are valid in IRIs.
+func GenerateSlug(title string) string {
+	var b strings.Builder
+	dash := false
+
+	write := func(s string) {
+		for _, r := range s {
+			if r == ' ' {
+				dash = b.Len() > 0
+				continue
+			}
+
+			if dash {
+				b.WriteByte('-')
+				dash = false
+			}
+			b.WriteRune(r)
+		}
+	}
+
+	for _, r := range norm.NFC.String(title) {
+		r = unicode.ToLower(r)
+
+		if t, ok := transliterations[r]; ok {
+			write(t)
+			continue
+		}
+
+		if r >= utf8.RuneSelf {
+			// strip accents and compatibility forms when the base letter is latin
+			// or has a transliteration, otherwise keep the letter untouched
+			decomposed := norm.NFKD.String(string(r))
+			base, _ := utf8.DecodeRuneInString(decomposed)
+			base = unicode.ToLower(base)
+
+			if t, ok := transliterations[base]; ok {
+				write(t)
+				continue
+			}
+
+			if base < utf8.RuneSelf {
+				for _, d := range strings.ToLower(decomposed) {
+					if d < utf8.RuneSelf && (unicode.IsLetter(d) || unicode.IsDigit(d)) {
+						write(string(d))
+					}
+				}
+				continue
+			}
+		}
+
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Mc, r) {
+			write(string(r))
+		} else {
+			write(" ")
+		}
+	}
+
+	slug := truncateSlug(b.String(), SlugMaxLength)
+	if slug == "" {
+		return slugFallback
+	}
+
+	return slug
+}
+
+// UniqueSlug returns slug, or slug with the lowest free numeric suffix when it
+// is already present in taken or collides with a fixed route.
+func UniqueSlug(slug string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, t := range taken {
+		used[t] = true
+	}
+
+	for n := 1; ; n++ {
+		candidate := SlugCandidate(slug, n)
+		if !used[candidate] && !reservedSlugs[candidate] {
+			return candidate
+		}
+	}
+}
+
+// SlugCandidate returns the n-th variant UniqueSlug tries for slug: slug
+// itself for n = 1, then slug cut to make room for a "-n" suffix.
+func SlugCandidate(slug string, n int) string {
+	if n <= 1 {
+		return slug
+	}
+
+	suffix := fmt.Sprintf("-%d", n)
+	return truncateSlug(slug, SlugMaxLength-len(suffix)) + suffix
+}
+
+func truncateSlug(slug string, max int) string {
+	if utf8.RuneCountInString(slug) <= max {
+		return strings.Trim(slug, "-")
+	}
+
+	runes := []rune(slug)[:max]
+	cut := string(runes)
+	if i := strings.LastIndex(cut, "-"); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.Trim(cut, "-")
+}
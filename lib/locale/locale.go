@@ -0,0 +1,83 @@
+// Package locale holds the languages content can be published in.
+package locale
+
+import (
+	"blog/config"
+	"strings"
+)
+
+const (
+	DefaultLocale    = "en"
+	DefaultSupported = "en,id"
+)
+
+type Locales interface {
+	// Default is the language of contents without a translation in the
+	// requested one.
+	Default() string
+	Supported() []string
+	IsSupported(code string) bool
+	// Normalize lowercases code and drops a region, so "id-ID" becomes "id".
+	Normalize(code string) string
+}
+
+type Options struct {
+	defaultLocale string
+	supported     []string
+}
+
+// Default implements Locales.
+func (o *Options) Default() string {
+	return o.defaultLocale
+}
+
+// Supported implements Locales.
+func (o *Options) Supported() []string {
+	return o.supported
+}
+
+// IsSupported implements Locales.
+func (o *Options) IsSupported(code string) bool {
+	code = o.Normalize(code)
+	for _, val := range o.supported {
+		if val == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Normalize implements Locales.
+func (o *Options) Normalize(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if i := strings.IndexAny(code, "-_"); i > 0 {
+		code = code[:i]
+	}
+
+	return code
+}
+
+func NewLocales(cfg *config.Config) Locales {
+	opt := new(Options)
+
+	opt.defaultLocale = opt.Normalize(cfg.Locale.Default)
+	if opt.defaultLocale == "" {
+		opt.defaultLocale = DefaultLocale
+	}
+
+	supported := cfg.Locale.Supported
+	if strings.TrimSpace(supported) == "" {
+		supported = DefaultSupported
+	}
+
+	opt.supported = []string{opt.defaultLocale}
+	for _, code := range strings.Split(supported, ",") {
+		code = opt.Normalize(code)
+		if code != "" && !opt.IsSupported(code) {
+			opt.supported = append(opt.supported, code)
+		}
+	}
+
+	return opt
+}
@@ -4,13 +4,17 @@ import (
 	"blog/config"
 	"blog/internal/adapter/handler/response"
 	"blog/lib/auth"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 type Middleware interface {
 	CheckToken() fiber.Handler
+	RateLimit(max int, window time.Duration) fiber.Handler
 }
 
 type Options struct {
@@ -43,6 +47,58 @@ func (o *Options) CheckToken() func(*fiber.Ctx) error {
 	}
 }
 
+// RateLimit limits the number of requests a single client IP can make to a
+// route within window. Counters are kept in memory per fixed window.
+func (o *Options) RateLimit(max int, window time.Duration) fiber.Handler {
+	type counter struct {
+		hits    int
+		resetAt time.Time
+	}
+
+	var mu sync.Mutex
+	counters := make(map[string]*counter)
+
+	go func() {
+		ticker := time.NewTicker(window)
+		defer ticker.Stop()
+
+		for now := range ticker.C {
+			mu.Lock()
+			for key, val := range counters {
+				if now.After(val.resetAt) {
+					delete(counters, key)
+				}
+			}
+			mu.Unlock()
+		}
+	}()
+
+	return func(c *fiber.Ctx) error {
+		var errorResponse response.ErrorResponseDefault
+		key := c.IP() + ":" + c.Route().Path
+		now := time.Now()
+
+		mu.Lock()
+		val, ok := counters[key]
+		if !ok || now.After(val.resetAt) {
+			val = &counter{resetAt: now.Add(window)}
+			counters[key] = val
+		}
+		val.hits++
+		hits, resetAt := val.hits, val.resetAt
+		mu.Unlock()
+
+		if hits > max {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(time.Until(resetAt).Seconds())+1))
+			errorResponse.Meta.Status = false
+			errorResponse.Meta.Message = "Too many requests, please try again later"
+			return c.Status(fiber.StatusTooManyRequests).JSON(errorResponse)
+		}
+
+		return c.Next()
+	}
+}
+
 func NewMiddleware(cfg *config.Config) Middleware {
 	opt := new(Options)
 	opt.authJwt = auth.NewJwt(cfg)
@@ -0,0 +1,26 @@
+package permalink
+
+import (
+	"net/url"
+	"strings"
+)
+
+func Home(baseUrl string) string {
+	return strings.TrimRight(baseUrl, "/") + "/"
+}
+
+func Content(baseUrl, slug string) string {
+	return strings.TrimRight(baseUrl, "/") + "/posts/" + url.PathEscape(slug)
+}
+
+func Category(baseUrl, slug string) string {
+	return strings.TrimRight(baseUrl, "/") + "/categories/" + url.PathEscape(slug)
+}
+
+func Tag(baseUrl, tag string) string {
+	return strings.TrimRight(baseUrl, "/") + "/tags/" + url.PathEscape(tag)
+}
+
+func Preview(baseUrl, token string) string {
+	return strings.TrimRight(baseUrl, "/") + "/preview/" + url.PathEscape(token)
+}
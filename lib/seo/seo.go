@@ -0,0 +1,210 @@
+// Package seo validates per-post search metadata and audits posts for common
+// search and share preview problems.
+package seo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"blog/lib/conv"
+
+	"golang.org/x/net/html"
+)
+
+// Recommended lengths, in characters, of what search engines display.
+const (
+	TitleMinLength       = 30
+	TitleMaxLength       = 60
+	DescriptionMinLength = 70
+	DescriptionMaxLength = 160
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+var ErrInvalidJsonLD = errors.New("json_ld must be a JSON-LD object or array of objects")
+
+// Page is what the audit looks at. Empty overrides fall back to the fields
+// the page would use without them, as the public site does.
+type Page struct {
+	Title           string
+	Excerpt         string
+	Body            string
+	Image           string
+	MetaTitle       string
+	MetaDescription string
+	CanonicalUrl    string
+	OgImage         string
+	NoIndex         bool
+	JsonLD          string
+}
+
+type Issue struct {
+	Field    string
+	Severity string
+	Message  string
+}
+
+// ValidateJsonLD checks that s is empty or holds a JSON object, or an array
+// of objects, that declares an @context or @type.
+func ValidateJsonLD(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return ErrInvalidJsonLD
+	}
+
+	docs := []interface{}{doc}
+	if list, ok := doc.([]interface{}); ok {
+		docs = list
+	}
+
+	if len(docs) == 0 {
+		return ErrInvalidJsonLD
+	}
+
+	for _, val := range docs {
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return ErrInvalidJsonLD
+		}
+		if _, ok := obj["@context"]; !ok {
+			if _, ok := obj["@type"]; !ok {
+				return ErrInvalidJsonLD
+			}
+		}
+	}
+
+	return nil
+}
+
+// Audit reports length problems of the effective title and description,
+// images without alt text and missing share metadata.
+func Audit(p Page) []Issue {
+	issues := []Issue{}
+
+	title := firstNonEmpty(p.MetaTitle, p.Title)
+	field := "title"
+	if p.MetaTitle != "" {
+		field = "meta_title"
+	}
+	issues = append(issues, checkLength(field, "title", title, TitleMinLength, TitleMaxLength)...)
+
+	description := firstNonEmpty(p.MetaDescription, conv.SanitizeText(p.Excerpt))
+	field = "excerpt"
+	if p.MetaDescription != "" {
+		field = "meta_description"
+	}
+	issues = append(issues, checkLength(field, "description", description, DescriptionMinLength, DescriptionMaxLength)...)
+
+	if missing := MissingAlt(p.Body); missing > 0 {
+		issues = append(issues, Issue{
+			Field:    "description",
+			Severity: SeverityWarning,
+			Message:  fmt.Sprintf("%d image(s) without alt text", missing),
+		})
+	}
+
+	if p.OgImage == "" && p.Image == "" {
+		issues = append(issues, Issue{
+			Field:    "og_image",
+			Severity: SeverityWarning,
+			Message:  "no image for share previews",
+		})
+	}
+
+	if err := ValidateJsonLD(p.JsonLD); err != nil {
+		issues = append(issues, Issue{
+			Field:    "json_ld",
+			Severity: SeverityError,
+			Message:  err.Error(),
+		})
+	}
+
+	if p.NoIndex {
+		issues = append(issues, Issue{
+			Field:    "noindex",
+			Severity: SeverityWarning,
+			Message:  "post is hidden from search engines",
+		})
+	}
+
+	return issues
+}
+
+// Score rates a page from 0 to 100, errors weighing more than warnings.
+func Score(issues []Issue) int {
+	score := 100
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			score -= 20
+		} else {
+			score -= 5
+		}
+	}
+
+	return max(score, 0)
+}
+
+// MissingAlt counts the img elements of body without a non-empty alt
+// attribute.
+func MissingAlt(body string) int {
+	missing := 0
+
+	tokenizer := html.NewTokenizer(strings.NewReader(body))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return missing
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if token.Data != "img" {
+				continue
+			}
+
+			alt := ""
+			for _, a := range token.Attr {
+				if a.Key == "alt" {
+					alt = strings.TrimSpace(a.Val)
+				}
+			}
+			if alt == "" {
+				missing++
+			}
+		}
+	}
+}
+
+func checkLength(field, name, value string, minLength, maxLength int) []Issue {
+	length := utf8.RuneCountInString(strings.TrimSpace(value))
+
+	switch {
+	case length == 0:
+		return []Issue{{Field: field, Severity: SeverityError, Message: name + " is empty"}}
+	case length < minLength:
+		return []Issue{{Field: field, Severity: SeverityWarning, Message: fmt.Sprintf("%s is %d characters, shorter than the recommended %d", name, length, minLength)}}
+	case length > maxLength:
+		return []Issue{{Field: field, Severity: SeverityWarning, Message: fmt.Sprintf("%s is %d characters, longer than the recommended %d", name, length, maxLength)}}
+	}
+
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, val := range values {
+		if strings.TrimSpace(val) != "" {
+			return val
+		}
+	}
+
+	return ""
+}
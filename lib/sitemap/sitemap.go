@@ -0,0 +1,101 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type Url struct {
+	Loc     string
+	LastMod time.Time
+	Images  []string
+}
+
+type Sitemap struct {
+	Loc     string
+	LastMod time.Time
+}
+
+type urlSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	NS      string   `xml:"xmlns,attr"`
+	ImageNS string   `xml:"xmlns:image,attr"`
+	Urls    []url    `xml:"url"`
+}
+
+type url struct {
+	Loc     string  `xml:"loc"`
+	LastMod string  `xml:"lastmod,omitempty"`
+	Images  []image `xml:"image:image"`
+}
+
+type image struct {
+	Loc string `xml:"image:loc"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	NS       string         `xml:"xmlns,attr"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// UrlSet renders a sitemap with the image extension for urls that carry images.
+func UrlSet(urls []Url) ([]byte, error) {
+	doc := urlSet{
+		NS:      namespace,
+		ImageNS: "http://www.google.com/schemas/sitemap-image/1.1",
+	}
+
+	for _, u := range urls {
+		entry := url{
+			Loc:     u.Loc,
+			LastMod: formatLastMod(u.LastMod),
+		}
+
+		for _, img := range u.Images {
+			entry.Images = append(entry.Images, image{Loc: img})
+		}
+
+		doc.Urls = append(doc.Urls, entry)
+	}
+
+	return marshal(doc)
+}
+
+// Index renders a sitemap index pointing at child sitemaps.
+func Index(sitemaps []Sitemap) ([]byte, error) {
+	doc := sitemapIndex{NS: namespace}
+
+	for _, s := range sitemaps {
+		doc.Sitemaps = append(doc.Sitemaps, sitemapEntry{
+			Loc:     s.Loc,
+			LastMod: formatLastMod(s.LastMod),
+		})
+	}
+
+	return marshal(doc)
+}
+
+func formatLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
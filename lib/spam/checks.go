@@ -0,0 +1,204 @@
+package spam
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LinkDensity flags submissions that contain too many links, either in
+// absolute terms or relative to the number of words.
+type LinkDensity struct {
+	MaxLinks int
+	MaxRatio float64
+}
+
+// Name implements Checker.
+func (l LinkDensity) Name() string {
+	return "link_density"
+}
+
+// Check implements Checker.
+func (l LinkDensity) Check(ctx context.Context, sub Submission) (Signal, error) {
+	links := len(Links(sub.Body)) + len(Links(sub.Name))
+	if links == 0 {
+		return Signal{}, nil
+	}
+
+	if links > l.MaxLinks {
+		return Signal{Score: 1, Reason: fmt.Sprintf("%d links, at most %d allowed", links, l.MaxLinks)}, nil
+	}
+
+	words := len(strings.Fields(sub.Body))
+	if words > 0 && l.MaxRatio > 0 && float64(links)/float64(words) > l.MaxRatio {
+		return Signal{Score: 0.5, Reason: fmt.Sprintf("%d links in %d words", links, words)}, nil
+	}
+
+	return Signal{}, nil
+}
+
+// BlocklistSource provides the blocked words and link domains.
+type BlocklistSource interface {
+	Blocklist(ctx context.Context) (words []string, domains []string, err error)
+}
+
+// Blocklist flags submissions containing blocked words, or linking to or
+// sent from blocked domains.
+type Blocklist struct {
+	Source BlocklistSource
+}
+
+// Name implements Checker.
+func (b Blocklist) Name() string {
+	return "blocklist"
+}
+
+// Check implements Checker.
+func (b Blocklist) Check(ctx context.Context, sub Submission) (Signal, error) {
+	words, domains, err := b.Source.Blocklist(ctx)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	var score float64
+	var hits []string
+
+	text := " " + strings.ToLower(nonWordRunes.ReplaceAllString(sub.Name+" "+sub.Body, " ")) + " "
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" && strings.Contains(text, " "+word+" ") {
+			score += 0.5
+			hits = append(hits, word)
+		}
+	}
+
+	linked := Domains(sub.Body + " " + sub.Name)
+	if at := strings.LastIndex(sub.Email, "@"); at >= 0 {
+		linked = append(linked, strings.ToLower(sub.Email[at+1:]))
+	}
+
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		for _, host := range linked {
+			if domain != "" && (host == domain || strings.HasSuffix(host, "."+domain)) {
+				score += 1
+				hits = append(hits, domain)
+				break
+			}
+		}
+	}
+
+	if score == 0 {
+		return Signal{}, nil
+	}
+
+	return Signal{Score: score, Reason: "blocked " + strings.Join(hits, ", ")}, nil
+}
+
+// Honeypot flags submissions that filled in a form field hidden from
+// humans.
+type Honeypot struct{}
+
+// Name implements Checker.
+func (h Honeypot) Name() string {
+	return "honeypot"
+}
+
+// Check implements Checker.
+func (h Honeypot) Check(ctx context.Context, sub Submission) (Signal, error) {
+	if strings.TrimSpace(sub.Honeypot) != "" {
+		return Signal{Score: 1, Reason: "hidden field was filled in"}, nil
+	}
+
+	return Signal{}, nil
+}
+
+// Timing flags submissions sent faster than a human could type them, or
+// without a valid token issued when the form was rendered.
+type Timing struct {
+	Secret   []byte
+	MinDelay time.Duration
+	MaxAge   time.Duration
+}
+
+// Issue returns a signed token recording the time the form was rendered.
+func (t Timing) Issue(now time.Time) string {
+	ts := strconv.FormatInt(now.Unix(), 10)
+	return ts + "." + t.sign(ts)
+}
+
+func (t Timing) sign(ts string) string {
+	mac := hmac.New(sha256.New, t.Secret)
+	mac.Write([]byte(ts))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Name implements Checker.
+func (t Timing) Name() string {
+	return "timing"
+}
+
+// Check implements Checker.
+func (t Timing) Check(ctx context.Context, sub Submission) (Signal, error) {
+	ts, sig, ok := strings.Cut(sub.Token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(t.sign(ts))) {
+		return Signal{Score: 1, Reason: "missing or invalid token"}, nil
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return Signal{Score: 1, Reason: "missing or invalid token"}, nil
+	}
+
+	elapsed := time.Since(time.Unix(unix, 0))
+	if elapsed < t.MinDelay {
+		return Signal{Score: 1, Reason: fmt.Sprintf("submitted after %s", elapsed.Round(time.Millisecond))}, nil
+	}
+
+	if t.MaxAge > 0 && elapsed > t.MaxAge {
+		return Signal{Score: 0.5, Reason: "token expired"}, nil
+	}
+
+	return Signal{}, nil
+}
+
+// FingerprintStore counts earlier submissions with the same fingerprint.
+type FingerprintStore interface {
+	CountFingerprint(ctx context.Context, fingerprint string, since time.Time) (recent int64, flagged int64, err error)
+}
+
+// Repeated flags messages that were already marked as spam, or that were
+// posted too many times within a window.
+type Repeated struct {
+	Store      FingerprintStore
+	Window     time.Duration
+	MaxRepeats int
+}
+
+// Name implements Checker.
+func (r Repeated) Name() string {
+	return "repeated"
+}
+
+// Check implements Checker.
+func (r Repeated) Check(ctx context.Context, sub Submission) (Signal, error) {
+	recent, flagged, err := r.Store.CountFingerprint(ctx, Fingerprint(sub.Body), time.Now().Add(-r.Window))
+	if err != nil {
+		return Signal{}, err
+	}
+
+	if flagged > 0 {
+		return Signal{Score: 1, Reason: "same message was marked as spam before"}, nil
+	}
+
+	if r.MaxRepeats > 0 && recent >= int64(r.MaxRepeats) {
+		return Signal{Score: 1, Reason: fmt.Sprintf("same message posted %d times recently", recent)}, nil
+	}
+
+	return Signal{}, nil
+}
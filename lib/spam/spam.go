@@ -0,0 +1,141 @@
+package spam
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Submission is a piece of anonymous user input such as a comment or a
+// contact form message.
+type Submission struct {
+	Name      string
+	Email     string
+	Body      string
+	IPAddress string
+	UserAgent string
+	Honeypot  string
+	Token     string
+}
+
+// Signal is the outcome of a single check. A score of 0 means the check
+// found nothing suspicious.
+type Signal struct {
+	Score  float64
+	Reason string
+}
+
+// Verdict is the combined outcome of every check in a pipeline.
+type Verdict struct {
+	Score   float64
+	Spam    bool
+	Reasons []string
+}
+
+type Checker interface {
+	Name() string
+	Check(ctx context.Context, sub Submission) (Signal, error)
+}
+
+type Pipeline interface {
+	Check(ctx context.Context, sub Submission) (Verdict, error)
+}
+
+type Options struct {
+	threshold float64
+	checkers  []Checker
+}
+
+// Check implements Pipeline. Every checker runs even if an earlier one
+// fails; the first error is returned together with the partial verdict so
+// the caller can decide whether to fail open.
+func (o *Options) Check(ctx context.Context, sub Submission) (Verdict, error) {
+	var verdict Verdict
+	var firstErr error
+
+	for _, checker := range o.checkers {
+		signal, err := checker.Check(ctx, sub)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if signal.Score <= 0 {
+			continue
+		}
+
+		verdict.Score += signal.Score
+		verdict.Reasons = append(verdict.Reasons, checker.Name()+": "+signal.Reason)
+	}
+
+	verdict.Spam = len(o.checkers) > 0 && verdict.Score >= o.threshold
+
+	return verdict, firstErr
+}
+
+// NewPipeline returns a pipeline that flags a submission as spam once the
+// summed score of its checkers reaches threshold.
+func NewPipeline(threshold float64, checkers ...Checker) Pipeline {
+	if threshold <= 0 {
+		threshold = 1
+	}
+
+	opt := new(Options)
+	opt.threshold = threshold
+	opt.checkers = checkers
+
+	return opt
+}
+
+var (
+	linkPattern  = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)
+	nonWordRunes = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+)
+
+// Links returns every link found in text.
+func Links(text string) []string {
+	return linkPattern.FindAllString(text, -1)
+}
+
+// Domains returns the lowercased host names of every link found in text,
+// without a leading "www.".
+func Domains(text string) []string {
+	var domains []string
+	seen := map[string]bool{}
+
+	for _, link := range Links(text) {
+		if !strings.Contains(link, "://") {
+			link = "http://" + link
+		}
+
+		parsed, err := url.Parse(link)
+		if err != nil || parsed.Hostname() == "" {
+			continue
+		}
+
+		host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+		if !seen[host] {
+			seen[host] = true
+			domains = append(domains, host)
+		}
+	}
+
+	return domains
+}
+
+// Fingerprint returns a stable hash of text that ignores case, punctuation
+// and whitespace, so trivially altered copies of the same message match.
+func Fingerprint(text string) string {
+	normalized := strings.ToLower(text)
+	normalized = nonWordRunes.ReplaceAllString(normalized, " ")
+	normalized = strings.Join(strings.FieldsFunc(normalized, unicode.IsSpace), " ")
+
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
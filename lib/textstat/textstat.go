@@ -0,0 +1,164 @@
+// Package textstat measures post bodies: word count, reading time and the
+// heading outline used as a table of contents.
+package textstat
+
+import (
+	"strings"
+	"unicode"
+
+	"blog/lib/conv"
+
+	"golang.org/x/net/html"
+)
+
+// WordsPerMinute is the reading speed used for the reading time estimate.
+const WordsPerMinute = 200
+
+// Heading is one entry of the table of contents.
+type Heading struct {
+	Level int
+	Text  string
+	ID    string
+}
+
+type Stats struct {
+	WordCount   int
+	ReadingTime int
+	Headings    []Heading
+}
+
+var headingLevels = map[string]int{"h2": 2, "h3": 3, "h4": 4}
+
+// inlineTags do not separate words, so "<b>bo</b>ld" is a single word.
+var inlineTags = map[string]bool{
+	"a": true, "abbr": true, "b": true, "code": true, "em": true, "i": true, "mark": true,
+	"s": true, "small": true, "span": true, "strong": true, "sub": true, "sup": true, "u": true,
+}
+
+// Analyze counts the words of an HTML body and collects its h2-h4 headings.
+// It returns the body with an id attribute on every collected heading so
+// the table of contents can link to it; ids already present are kept.
+func Analyze(body string) (string, Stats) {
+	var out strings.Builder
+	stats := Stats{Headings: []Heading{}}
+	used := []string{}
+	skip := 0
+
+	var text strings.Builder
+
+	var heading *html.Token
+	var headingRaw strings.Builder
+	var headingText strings.Builder
+
+	tokenizer := html.NewTokenizer(strings.NewReader(body))
+	for {
+		tt := tokenizer.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+
+		raw := string(tokenizer.Raw())
+		token := tokenizer.Token()
+
+		if tt != html.TextToken && !inlineTags[token.Data] {
+			text.WriteByte(' ')
+		}
+
+		switch tt {
+		case html.StartTagToken:
+			if token.Data == "script" || token.Data == "style" {
+				skip++
+			}
+			if _, ok := headingLevels[token.Data]; ok && heading == nil {
+				heading = &token
+				continue
+			}
+		case html.EndTagToken:
+			if (token.Data == "script" || token.Data == "style") && skip > 0 {
+				skip--
+			}
+			if heading != nil && token.Data == heading.Data {
+				text := strings.Join(strings.Fields(headingText.String()), " ")
+
+				id := attr(*heading, "id")
+				if id == "" {
+					id = conv.UniqueSlug(conv.GenerateSlug(text), used)
+					heading.Attr = append(heading.Attr, html.Attribute{Key: "id", Val: id})
+				}
+				used = append(used, id)
+
+				stats.Headings = append(stats.Headings, Heading{
+					Level: headingLevels[heading.Data],
+					Text:  text,
+					ID:    id,
+				})
+
+				out.WriteString(heading.String())
+				out.WriteString(headingRaw.String())
+				out.WriteString(raw)
+
+				heading = nil
+				headingRaw.Reset()
+				headingText.Reset()
+				continue
+			}
+		case html.TextToken:
+			if skip == 0 {
+				text.WriteString(token.Data)
+				if heading != nil {
+					headingText.WriteString(token.Data)
+				}
+			}
+		}
+
+		if heading != nil {
+			headingRaw.WriteString(raw)
+			continue
+		}
+		out.WriteString(raw)
+	}
+
+	// an unterminated heading is written back untouched
+	if heading != nil {
+		out.WriteString(heading.String())
+		out.WriteString(headingRaw.String())
+	}
+
+	stats.WordCount = CountWords(text.String())
+	stats.ReadingTime = ReadingTime(stats.WordCount)
+
+	return out.String(), stats
+}
+
+// CountWords counts the whitespace separated words of s that contain at
+// least one letter or digit, so stray punctuation is not counted.
+func CountWords(s string) int {
+	count := 0
+	for _, field := range strings.Fields(s) {
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ReadingTime returns the minutes needed to read words, rounded up. Any
+// non-empty text takes at least a minute.
+func ReadingTime(words int) int {
+	if words <= 0 {
+		return 0
+	}
+
+	return (words + WordsPerMinute - 1) / WordsPerMinute
+}
+
+func attr(token html.Token, key string) string {
+	for _, a := range token.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
@@ -26,7 +26,17 @@ func ValidateStruct(s interface{}) error {
 			case "min":
 				if err.Field() == "Password" || err.Field() == "NewPassword" || err.Field() == "ConfirmPassword" {
 					errorMessages = append(errorMessages, "Password must be at least 8 characters")
+				} else {
+					errorMessages = append(errorMessages, "Field "+err.Field()+" must be at least "+err.Param())
 				}
+			case "max":
+				errorMessages = append(errorMessages, "Field "+err.Field()+" must be at most "+err.Param())
+			case "url":
+				errorMessages = append(errorMessages, "Field "+err.Field()+" must be a valid URL")
+			case "oneof":
+				errorMessages = append(errorMessages, "Field "+err.Field()+" must be one of: "+err.Param())
+			case "json":
+				errorMessages = append(errorMessages, "Field "+err.Field()+" must be valid JSON")
 			case "eqfield":
 				errorMessages = append(errorMessages, "Field "+err.Field()+" must be equal to "+err.Param())
 			default:
@@ -0,0 +1,19 @@
+package visitor
+
+import (
+	"regexp"
+	"strings"
+)
+
+var botPattern = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|scrap|preview|fetch|monitor|curl|wget|python-requests|go-http-client|httpclient|headless|lighthouse|facebookexternalhit|embedly|whatsapp|feed`)
+
+// IsBot reports whether userAgent looks like a crawler, link previewer or
+// scripted client. An empty user agent is treated as a bot.
+func IsBot(userAgent string) bool {
+	userAgent = strings.TrimSpace(userAgent)
+	if userAgent == "" {
+		return true
+	}
+
+	return botPattern.MatchString(userAgent)
+}
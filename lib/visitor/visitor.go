@@ -0,0 +1,77 @@
+package visitor
+
+import (
+	"blog/config"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const CookieName = "visitor_id"
+
+type Visitor interface {
+	Hash(c *fiber.Ctx) string
+}
+
+type Options struct {
+	secret []byte
+}
+
+// Hash implements Visitor. It identifies an anonymous reader by the
+// visitor cookie, falling back to their IP address and user agent. The
+// cookie carries the identifier with its signature, so only identifiers
+// issued here are trusted; a missing or forged cookie is replaced by one
+// holding the fallback identifier. Only a keyed hash of the identifier ever
+// leaves this function.
+func (o *Options) Hash(c *fiber.Ctx) string {
+	id, ok := o.verify(c.Cookies(CookieName))
+	if !ok {
+		id = o.sign("fallback:" + c.IP() + "|" + c.Get(fiber.HeaderUserAgent))
+		c.Cookie(&fiber.Cookie{
+			Name:     CookieName,
+			Value:    id + "." + o.sign("cookie:"+id),
+			Path:     "/",
+			Expires:  time.Now().AddDate(1, 0, 0),
+			HTTPOnly: true,
+			SameSite: fiber.CookieSameSiteLaxMode,
+		})
+	}
+
+	return o.sign("visitor:" + id)
+}
+
+// verify returns the identifier of a cookie value when its signature holds.
+func (o *Options) verify(value string) (string, bool) {
+	id, signature, found := strings.Cut(value, ".")
+	if !found || len(id) != 64 {
+		return "", false
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(o.sign("cookie:"+id))) {
+		return "", false
+	}
+
+	return id, true
+}
+
+func (o *Options) sign(value string) string {
+	mac := hmac.New(sha256.New, o.secret)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func NewVisitor(cfg *config.Config) Visitor {
+	secret := cfg.Spam.Secret
+	if secret == "" {
+		secret = cfg.App.JwtSecretKey
+	}
+
+	opt := new(Options)
+	opt.secret = []byte(secret)
+
+	return opt
+}